@@ -0,0 +1,258 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"strings"
+)
+
+const (
+	alterTableMarkerInit injectionMarker = iota
+	alterTableMarkerAfterAlter
+	alterTableMarkerAfterAction
+)
+
+// NewAlterTableBuilder creates a new ALTER TABLE builder.
+func NewAlterTableBuilder() *AlterTableBuilder {
+	return DefaultFlavor.NewAlterTableBuilder()
+}
+
+func newAlterTableBuilder() *AlterTableBuilder {
+	return &AlterTableBuilder{
+		args:      &Args{},
+		injection: newInjection(),
+		marker:    alterTableMarkerInit,
+	}
+}
+
+// AlterTableBuilder is a builder to build ALTER TABLE.
+//
+// Actions are written in the order they are added and separated by commas.
+// SQLite, SQLServer and Oracle don't support multiple actions separated by commas,
+// and PostgreSQL and DuckDB don't support RENAME COLUMN with other actions.
+// Build one ALTER TABLE per action for them. `BuildWithFlavorStrict` reports
+// these combinations as errors wrapping ErrUnsupportedFlavor.
+type AlterTableBuilder struct {
+	table   string
+	actions []alterTableAction
+
+	args *Args
+
+	injection *injection
+	marker    injectionMarker
+}
+
+type alterTableActionKind int
+
+const (
+	alterTableAddColumn alterTableActionKind = iota
+	alterTableDropColumn
+	alterTableRenameColumn
+	alterTableAddIndex
+)
+
+type alterTableAction struct {
+	kind alterTableActionKind
+	name string
+	args []string
+}
+
+var _ Builder = new(AlterTableBuilder)
+
+// AlterTable sets the table name in ALTER TABLE.
+func AlterTable(table string) *AlterTableBuilder {
+	return DefaultFlavor.NewAlterTableBuilder().AlterTable(table)
+}
+
+// AlterTable sets the table name in ALTER TABLE.
+func (atb *AlterTableBuilder) AlterTable(table string) *AlterTableBuilder {
+	atb.table = Escape(table)
+	atb.marker = alterTableMarkerAfterAlter
+	return atb
+}
+
+// AddColumn adds an action to add a column with definition def, e.g. `AddColumn("name", "VARCHAR(255)", "NOT NULL")`.
+// The def is written as it is like `CreateTableBuilder#Define`.
+//
+// It's written as "ADD COLUMN def" in most flavors and "ADD def" in SQLServer and Oracle.
+func (atb *AlterTableBuilder) AddColumn(def ...string) *AlterTableBuilder {
+	return atb.addAction(alterTableAddColumn, "", def)
+}
+
+// DropColumn adds an action to drop a column.
+func (atb *AlterTableBuilder) DropColumn(name string) *AlterTableBuilder {
+	return atb.addAction(alterTableDropColumn, Escape(name), nil)
+}
+
+// RenameColumn adds an action to rename a column from oldName to newName.
+//
+// It's written as "RENAME COLUMN oldName TO newName", which is supported by MySQL 8.0+, MariaDB 10.5+,
+// PostgreSQL, SQLite, Oracle and DuckDB.
+// SQLServer doesn't support renaming a column in ALTER TABLE. Use "EXEC sp_rename" instead.
+// It's omitted in SQLServer and `BuildWithFlavorStrict` reports an error wrapping ErrUnsupportedFlavor.
+func (atb *AlterTableBuilder) RenameColumn(oldName, newName string) *AlterTableBuilder {
+	return atb.addAction(alterTableRenameColumn, Escape(oldName), []string{Escape(newName)})
+}
+
+// AddIndex adds an action to add an index named name on cols, e.g. "ADD INDEX idx_name (name, created_at)".
+//
+// It's only written in MySQL and MariaDB and omitted in other flavors,
+// as other database systems create indexes by CREATE INDEX. Use `CreateIndexBuilder` instead.
+// `BuildWithFlavorStrict` reports an omitted ADD INDEX as an error wrapping ErrUnsupportedFlavor.
+func (atb *AlterTableBuilder) AddIndex(name string, col ...string) *AlterTableBuilder {
+	return atb.addAction(alterTableAddIndex, Escape(name), EscapeAll(col...))
+}
+
+func (atb *AlterTableBuilder) addAction(kind alterTableActionKind, name string, args []string) *AlterTableBuilder {
+	atb.actions = append(atb.actions, alterTableAction{
+		kind: kind,
+		name: name,
+		args: args,
+	})
+	atb.marker = alterTableMarkerAfterAction
+	return atb
+}
+
+// NumAction returns the number of actions in ALTER TABLE.
+func (atb *AlterTableBuilder) NumAction() int {
+	return len(atb.actions)
+}
+
+// String returns the compiled ALTER TABLE string.
+func (atb *AlterTableBuilder) String() string {
+	s, _ := atb.Build()
+	return s
+}
+
+// Build returns compiled ALTER TABLE string and args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (atb *AlterTableBuilder) Build() (sql string, args []interface{}) {
+	return atb.BuildWithFlavor(atb.args.Flavor)
+}
+
+// BuildWithFlavorStrict works like `BuildWithFlavor` and validates the compiled ALTER TABLE.
+// It returns an error wrapping ErrUnsupportedFlavor if any action would be omitted in flavor
+// or actions cannot be combined in one ALTER TABLE in flavor.
+func (atb *AlterTableBuilder) BuildWithFlavorStrict(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}, err error) {
+	return buildStrict(atb, flavor, initialArg...)
+}
+
+func (atb *AlterTableBuilder) checkFlavor(flavor Flavor) error {
+	hasRename := false
+
+	for _, action := range atb.actions {
+		switch action.kind {
+		case alterTableRenameColumn:
+			if flavor == SQLServer {
+				return unsupportedFlavorError("RENAME COLUMN", flavor)
+			}
+
+			hasRename = true
+
+		case alterTableAddIndex:
+			if !flavor.isMySQLCompatible() {
+				return unsupportedFlavorError("ADD INDEX", flavor)
+			}
+		}
+	}
+
+	if len(atb.actions) <= 1 {
+		return nil
+	}
+
+	switch flavor {
+	case SQLite, SQLServer, Oracle:
+		return unsupportedFlavorError("multiple actions in ALTER TABLE", flavor)
+
+	case PostgreSQL, DuckDB:
+		if hasRename {
+			return unsupportedFlavorError("RENAME COLUMN with other actions", flavor)
+		}
+	}
+
+	return nil
+}
+
+// BuildWithFlavor returns compiled ALTER TABLE string and args with flavor and initial args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (atb *AlterTableBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	buf := newStringBuilder()
+	atb.injection.WriteTo(buf, alterTableMarkerInit)
+
+	if len(atb.table) > 0 {
+		buf.WriteLeadingString("ALTER TABLE ")
+		buf.WriteString(atb.table)
+	}
+
+	atb.injection.WriteTo(buf, alterTableMarkerAfterAlter)
+
+	actions := make([]string, 0, len(atb.actions))
+
+	for _, action := range atb.actions {
+		if s := action.String(flavor); s != "" {
+			actions = append(actions, s)
+		}
+	}
+
+	if len(actions) > 0 {
+		buf.WriteLeadingString(strings.Join(actions, ", "))
+		atb.injection.WriteTo(buf, alterTableMarkerAfterAction)
+	}
+
+	return atb.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+}
+
+// String returns the action in flavor.
+// It returns an empty string if the action is not supported by flavor.
+func (action alterTableAction) String(flavor Flavor) string {
+	switch action.kind {
+	case alterTableAddColumn:
+		if len(action.args) == 0 {
+			return ""
+		}
+
+		if flavor == SQLServer || flavor == Oracle {
+			return "ADD " + strings.Join(action.args, " ")
+		}
+
+		return "ADD COLUMN " + strings.Join(action.args, " ")
+
+	case alterTableDropColumn:
+		return "DROP COLUMN " + action.name
+
+	case alterTableRenameColumn:
+		if flavor == SQLServer {
+			return ""
+		}
+
+		return "RENAME COLUMN " + action.name + " TO " + action.args[0]
+
+	case alterTableAddIndex:
+		if !flavor.isMySQLCompatible() {
+			return ""
+		}
+
+		return "ADD INDEX " + action.name + " (" + strings.Join(action.args, ", ") + ")"
+	}
+
+	return ""
+}
+
+// SetFlavor sets the flavor of compiled sql.
+func (atb *AlterTableBuilder) SetFlavor(flavor Flavor) (old Flavor) {
+	old = atb.args.Flavor
+	atb.args.Flavor = flavor
+	return
+}
+
+// Flavor returns flavor of builder
+func (atb *AlterTableBuilder) Flavor() Flavor {
+	return atb.args.Flavor
+}
+
+// SQL adds an arbitrary sql to current position.
+func (atb *AlterTableBuilder) SQL(sql string) *AlterTableBuilder {
+	atb.injection.SQL(atb.marker, sql)
+	return atb
+}
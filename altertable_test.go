@@ -0,0 +1,88 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleAlterTable() {
+	atb := AlterTable("demo.user")
+	atb.AddColumn("nickname", "VARCHAR(255)", "NOT NULL", "DEFAULT ''")
+	atb.DropColumn("legacy_name")
+	atb.RenameColumn("modified_at", "updated_at")
+	atb.AddIndex("idx_nickname", "nickname")
+
+	fmt.Println(atb)
+
+	// Output:
+	// ALTER TABLE demo.user ADD COLUMN nickname VARCHAR(255) NOT NULL DEFAULT '', DROP COLUMN legacy_name, RENAME COLUMN modified_at TO updated_at, ADD INDEX idx_nickname (nickname)
+}
+
+func ExampleAlterTableBuilder_SQL() {
+	atb := NewAlterTableBuilder()
+	atb.SQL("/* before */")
+	atb.AlterTable("demo.user")
+	atb.SQL("/* after alter */")
+	atb.DropColumn("legacy_name")
+	atb.SQL("/* after action */")
+
+	fmt.Println(atb)
+
+	// Output:
+	// /* before */ ALTER TABLE demo.user /* after alter */ DROP COLUMN legacy_name /* after action */
+}
+
+func TestAlterTableBuilderFlavors(t *testing.T) {
+	a := assert.New(t)
+	atb := AlterTable("t")
+	atb.AddColumn("age", "INT").AddColumn().AddIndex("idx_age", "age", "id")
+
+	cases := map[Flavor]string{
+		MySQL:      "ALTER TABLE t ADD COLUMN age INT, ADD INDEX idx_age (age, id)",
+		PostgreSQL: "ALTER TABLE t ADD COLUMN age INT",
+		SQLServer:  "ALTER TABLE t ADD age INT",
+		Oracle:     "ALTER TABLE t ADD age INT",
+	}
+
+	for flavor, expected := range cases {
+		sql, args := atb.BuildWithFlavor(flavor)
+		a.Equal(sql, expected)
+		a.Equal(len(args), 0)
+	}
+
+	a.Equal(atb.NumAction(), 3)
+
+	_, _, err := atb.BuildWithFlavorStrict(MySQL)
+	a.NilError(err)
+	_, _, err = atb.BuildWithFlavorStrict(PostgreSQL)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+
+	atb = AlterTable("t").AddColumn("c INT").DropColumn("d")
+	_, _, err = atb.BuildWithFlavorStrict(PostgreSQL)
+	a.NilError(err)
+	_, _, err = atb.BuildWithFlavorStrict(SQLite)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+
+	atb.RenameColumn("x", "y")
+	_, _, err = atb.BuildWithFlavorStrict(PostgreSQL)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+
+	atb = AlterTable("t").RenameColumn("x", "y")
+	sql, _, err := atb.BuildWithFlavorStrict(SQLite)
+	a.NilError(err)
+	a.Equal(sql, "ALTER TABLE t RENAME COLUMN x TO y")
+	_, _, err = atb.BuildWithFlavorStrict(SQLServer)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+	sql, _ = atb.BuildWithFlavor(SQLServer)
+	a.Equal(sql, "ALTER TABLE t")
+
+	atbPg := PostgreSQL.NewAlterTableBuilder()
+	a.Equal(PostgreSQL, atbPg.Flavor())
+	a.Equal(atbPg.String(), "")
+}
@@ -82,6 +82,39 @@ func (args *Args) add(arg interface{}) int {
 	return idx
 }
 
+// clone returns a deep copy of args.
+// Values in args are copied by assignment.
+func (args *Args) clone() *Args {
+	cloned := &Args{
+		Flavor:    args.Flavor,
+		indexBase: args.indexBase,
+		onlyNamed: args.onlyNamed,
+	}
+
+	if args.argValues != nil {
+		cloned.argValues = make([]interface{}, len(args.argValues))
+		copy(cloned.argValues, args.argValues)
+	}
+
+	if args.namedArgs != nil {
+		cloned.namedArgs = make(map[string]int, len(args.namedArgs))
+
+		for k, v := range args.namedArgs {
+			cloned.namedArgs[k] = v
+		}
+	}
+
+	if args.sqlNamedArgs != nil {
+		cloned.sqlNamedArgs = make(map[string]int, len(args.sqlNamedArgs))
+
+		for k, v := range args.sqlNamedArgs {
+			cloned.sqlNamedArgs[k] = v
+		}
+	}
+
+	return cloned
+}
+
 // Compile compiles builder's format to standard sql and returns associated args.
 //
 // The format string uses a special syntax to represent arguments.
@@ -104,6 +137,7 @@ func (args *Args) CompileWithFlavor(format string, flavor Flavor, initialValue .
 		stringBuilder: newStringBuilder(),
 		Flavor:        flavor,
 		Values:        initialValue,
+		args:          args,
 	}
 
 	if ctx.Flavor == invalidFlavor {
@@ -149,6 +183,34 @@ func (args *Args) CompileWithFlavor(format string, flavor Flavor, initialValue .
 	return
 }
 
+// fieldArg returns the arg referenced by field if field is exactly a "$n" var ref
+// to a field expression or an Expression.
+func (args *Args) fieldArg(field string) (arg interface{}, ok bool) {
+	if len(field) < 2 || field[0] != '$' {
+		return
+	}
+
+	for i := 1; i < len(field); i++ {
+		if field[i] < '0' || field[i] > '9' {
+			return
+		}
+	}
+
+	pointer, err := strconv.Atoi(field[1:])
+	offset := pointer - args.indexBase
+
+	if err != nil || offset < 0 || offset >= len(args.argValues) {
+		return
+	}
+
+	switch a := args.argValues[offset].(type) {
+	case fieldExpr, Expression:
+		return a, true
+	}
+
+	return
+}
+
 func (args *Args) compileNamed(ctx *argsCompileContext, format string) string {
 	i := 1
 
@@ -197,7 +259,16 @@ func (args *Args) compileSuccessive(ctx *argsCompileContext, format string, offs
 	}
 
 	arg := args.argValues[offset]
-	ctx.WriteValue(arg)
+
+	switch a := arg.(type) {
+	case Expression:
+		// Args of an Expression are bound every time it's written.
+		ctx.WriteValue(a)
+	case Builder:
+		ctx.WriteBuilder(offset, a)
+	default:
+		ctx.WriteValue(arg)
+	}
 
 	return format, offset + 1
 }
@@ -279,10 +350,86 @@ type argsCompileContext struct {
 	Flavor    Flavor
 	Values    []interface{}
 	NamedArgs []sql.NamedArg
+
+	// The args being compiled, which is used to resolve field refs in fields.
+	args *Args
+
+	// Compiled nested builders referenced in current compile pass.
+	// The key is the index of the builder in Args.
+	compiledBuilders map[int]*compiledBuilderResult
+}
+
+type compiledBuilderResult struct {
+	sql       string
+	values    []interface{}
+	namedArgs []sql.NamedArg
+}
+
+// WriteBuilder writes a nested builder referenced by the key.
+//
+// A builder is built only once in a compile pass. If the same builder is referenced again,
+// the compiled SQL is reused. For flavors using numbered placeholders, e.g. PostgreSQL and SQL Server,
+// the placeholders in the compiled SQL refer the same values so that values are bound only once.
+// For other flavors, e.g. MySQL, values are appended again as every "?" requires a value.
+func (ctx *argsCompileContext) WriteBuilder(key int, b Builder) {
+	if result, ok := ctx.compiledBuilders[key]; ok {
+		ctx.WriteString(result.sql)
+
+		if !ctx.Flavor.isNumberedPlaceholder() {
+			ctx.Values = append(ctx.Values, result.values...)
+		}
+
+		ctx.NamedArgs = append(ctx.NamedArgs, result.namedArgs...)
+		return
+	}
+
+	start := len(ctx.Values)
+	s, values := b.BuildWithFlavor(ctx.Flavor, ctx.Values...)
+	ctx.WriteString(s)
+
+	// Add all values to ctx.
+	// Named args must be located at the end of values.
+	values, namedArgs := parseNamedArgs(values)
+	ctx.Values = values
+	ctx.NamedArgs = append(ctx.NamedArgs, namedArgs...)
+
+	if ctx.compiledBuilders == nil {
+		ctx.compiledBuilders = map[int]*compiledBuilderResult{}
+	}
+
+	result := &compiledBuilderResult{
+		sql:       s,
+		namedArgs: namedArgs,
+	}
+
+	if start <= len(values) {
+		result.values = make([]interface{}, len(values)-start)
+		copy(result.values, values[start:])
+	}
+
+	ctx.compiledBuilders[key] = result
+}
+
+// WriteField writes a field of an expression built by Cond.
+// If field is exactly a var ref to a field expression, e.g. the one returned by `Cond#JSONExtract`,
+// or an Expression, the expression is written. Otherwise, field is written as it is.
+func (ctx *argsCompileContext) WriteField(field string) {
+	if ctx.args != nil {
+		if arg, ok := ctx.args.fieldArg(field); ok {
+			ctx.WriteValue(arg)
+			return
+		}
+	}
+
+	ctx.WriteString(field)
 }
 
 func (ctx *argsCompileContext) WriteValue(arg interface{}) {
 	switch a := arg.(type) {
+	// Expression must be checked before Builder as it implements Builder.
+	case Expression:
+		a.writeTo(ctx)
+
 	case Builder:
 		s, values := a.BuildWithFlavor(ctx.Flavor, ctx.Values...)
 		ctx.WriteString(s)
@@ -294,7 +441,14 @@ func (ctx *argsCompileContext) WriteValue(arg interface{}) {
 		ctx.NamedArgs = append(ctx.NamedArgs, namedArgs...)
 
 	case sql.NamedArg:
-		ctx.WriteRune('@')
+		// Oracle uses ":name" for named binds.
+		// Other flavors keep "@name", which is used by SQLServer and SQLite.
+		if ctx.Flavor == Oracle {
+			ctx.WriteRune(':')
+		} else {
+			ctx.WriteRune('@')
+		}
+
 		ctx.WriteString(a.Name)
 		ctx.NamedArgs = append(ctx.NamedArgs, a)
 
@@ -322,9 +476,18 @@ func (ctx *argsCompileContext) WriteValue(arg interface{}) {
 	case condBuilder:
 		a.Builder(ctx)
 
+	case fieldExpr:
+		a.Builder(ctx)
+
+	case colOperand:
+		ctx.WriteField(a.name)
+
+	case valueOperand:
+		ctx.WriteValue(a.value)
+
 	default:
 		switch ctx.Flavor {
-		case MySQL, SQLite, CQL, ClickHouse, Presto, Informix:
+		case MySQL, SQLite, CQL, ClickHouse, Presto, Informix, ANSI, MariaDB, DuckDB:
 			ctx.WriteRune('?')
 		case PostgreSQL:
 			fmt.Fprintf(ctx, "$%d", len(ctx.Values)+1)
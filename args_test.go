@@ -138,3 +138,22 @@ func TestArgsAdd(t *testing.T) {
 		a.Equal(actual, fmt.Sprintf("$%v", i))
 	}
 }
+
+func TestArgsSQLNamedArgFlavor(t *testing.T) {
+	a := assert.New(t)
+	start := sql.Named("start", 1234567890)
+	end := sql.Named("end", 1234599999)
+
+	sb := Select("id").From("user")
+	sb.Where(sb.Between("created_at", start, end), sb.GreaterThan("modified_at", start), sb.Equal("status", 1))
+
+	sql, args := sb.BuildWithFlavor(Oracle)
+	a.Equal(sql, "SELECT id FROM user WHERE created_at BETWEEN :start AND :end AND modified_at > :start AND status = :1")
+	a.Equal(args, []interface{}{1, start, end})
+
+	sql, _ = sb.BuildWithFlavor(SQLServer)
+	a.Equal(sql, "SELECT id FROM user WHERE created_at BETWEEN @start AND @end AND modified_at > @start AND status = @p1")
+
+	sql, _ = sb.BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "SELECT id FROM user WHERE created_at BETWEEN @start AND @end AND modified_at > @start AND status = $1")
+}
@@ -4,9 +4,18 @@
 package sqlbuilder
 
 import (
+	"context"
 	"fmt"
 )
 
+// OnBuild is an optional hook called by `BuildContext` methods after a SQL is built.
+// The builderType is the kind of the builder, e.g. "SELECT".
+//
+// It's useful to integrate with tracing systems, e.g. attaching query shape to a span.
+// OnBuild is nil by default and should be set before any builder is used,
+// as it's not safe to change it concurrently.
+var OnBuild func(ctx context.Context, sql string, builderType string)
+
 // Builder is a general SQL builder.
 // It's used by Args to create nested SQL like the `IN` expression in
 // `SELECT * FROM t1 WHERE id IN (SELECT id FROM t2)`.
@@ -151,3 +151,21 @@ func TestBuilderGetFlavor(t *testing.T) {
 	a.Equal(PostgreSQL, flavoredBuilder.Flavor())
 
 }
+
+func TestBuildWithRepeatedBuilder(t *testing.T) {
+	a := assert.New(t)
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("id").From("t1").Where(sb.E("level", 2))
+
+	sql, args := WithFlavor(Build("SELECT $1 FROM ($0) AS a JOIN ($0) AS b ON a.id = b.id", sb, 1234), PostgreSQL).Build()
+	a.Equal(sql, "SELECT $1 FROM (SELECT id FROM t1 WHERE level = $2) AS a JOIN (SELECT id FROM t1 WHERE level = $2) AS b ON a.id = b.id")
+	a.Equal(args, []interface{}{1234, 2})
+
+	sql, args = WithFlavor(Build("SELECT $1 FROM ($0) AS a JOIN ($0) AS b ON a.id = b.id", sb, 1234), SQLServer).Build()
+	a.Equal(sql, "SELECT @p1 FROM (SELECT id FROM t1 WHERE level = @p2) AS a JOIN (SELECT id FROM t1 WHERE level = @p2) AS b ON a.id = b.id")
+	a.Equal(args, []interface{}{1234, 2})
+
+	sql, args = Build("SELECT $1 FROM ($0) AS a JOIN ($0) AS b ON a.id = b.id", sb, 1234).Build()
+	a.Equal(sql, "SELECT ? FROM (SELECT id FROM t1 WHERE level = ?) AS a JOIN (SELECT id FROM t1 WHERE level = ?) AS b ON a.id = b.id")
+	a.Equal(args, []interface{}{1234, 2, 2})
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+// CaseBuilder is a builder to build a CASE expression.
+// It's created by `Cond#Case` or `Cond#CaseValue` and shares args with the Cond.
+//
+// Values in THEN and ELSE are bound as args.
+// Use `Raw` or `Cond#Col` to write a column or an expression as it is.
+type CaseBuilder struct {
+	args *Args
+
+	value    string
+	whens    []string
+	elseVar  string
+	hasElse  bool
+	hasValue bool
+}
+
+// Case starts a searched CASE expression "CASE WHEN cond THEN v ... ELSE v END".
+// Call `CaseBuilder#End` to get the expression.
+func (c *Cond) Case() *CaseBuilder {
+	return &CaseBuilder{
+		args: c.Args,
+	}
+}
+
+// CaseValue starts a simple CASE expression "CASE expr WHEN v THEN v ... ELSE v END".
+// The expr is written as it is.
+// Call `CaseBuilder#End` to get the expression.
+func (c *Cond) CaseValue(expr string) *CaseBuilder {
+	return &CaseBuilder{
+		args:     c.Args,
+		value:    expr,
+		hasValue: true,
+	}
+}
+
+// When adds a "WHEN cond THEN then" branch.
+//
+// In a searched CASE, cond is an expression written as it is.
+// In a simple CASE created by `Cond#CaseValue`, cond is compared to the case value.
+// It's still written as it is, so quote a string literal or use `CaseBuilder#WhenValue` to bind it.
+func (cb *CaseBuilder) When(cond string, then interface{}) *CaseBuilder {
+	if len(cond) == 0 {
+		return cb
+	}
+
+	cb.whens = append(cb.whens, "WHEN "+cond+" THEN "+cb.args.Add(then))
+	return cb
+}
+
+// WhenValue adds a "WHEN value THEN then" branch with both value and then bound as args.
+// It's designed for simple CASE created by `Cond#CaseValue`.
+func (cb *CaseBuilder) WhenValue(value, then interface{}) *CaseBuilder {
+	cb.whens = append(cb.whens, "WHEN "+cb.args.Add(value)+" THEN "+cb.args.Add(then))
+	return cb
+}
+
+// Else sets the "ELSE v" branch.
+func (cb *CaseBuilder) Else(v interface{}) *CaseBuilder {
+	cb.elseVar = cb.args.Add(v)
+	cb.hasElse = true
+	return cb
+}
+
+// End returns the CASE expression.
+// The expression can be used in `SelectBuilder#Select`, `SelectBuilder#OrderBy`, `UpdateBuilder#Set`
+// or anywhere accepting an expression in the builder sharing args with this CaseBuilder.
+//
+// If there is no WHEN branch, End returns the ELSE value or an empty string if there is no ELSE.
+func (cb *CaseBuilder) End() string {
+	if len(cb.whens) == 0 {
+		return cb.elseVar
+	}
+
+	buf := newStringBuilder()
+	buf.WriteString("CASE")
+
+	if cb.hasValue {
+		buf.WriteString(" ")
+		buf.WriteString(cb.value)
+	}
+
+	buf.WriteString(" ")
+	buf.WriteStrings(cb.whens, " ")
+
+	if cb.hasElse {
+		buf.WriteString(" ELSE ")
+		buf.WriteString(cb.elseVar)
+	}
+
+	buf.WriteString(" END")
+	return buf.String()
+}
@@ -0,0 +1,48 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleCond_Case() {
+	sb := Select("id")
+	level := sb.Case().
+		When(sb.GreaterEqualThan("score", 90), "A").
+		When(sb.GreaterEqualThan("score", 60), "B").
+		Else("C").
+		End()
+	sb.SelectMore(sb.As(level, "level")).From("students")
+	sb.OrderBy(sb.CaseValue("status").WhenValue("active", 0).Else(1).End(), "id")
+
+	sql, args := sb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT id, CASE WHEN score >= ? THEN ? WHEN score >= ? THEN ? ELSE ? END AS level FROM students ORDER BY CASE status WHEN ? THEN ? ELSE ? END, id
+	// [90 A 60 B C active 0 1]
+}
+
+func TestCaseBuilder(t *testing.T) {
+	a := assert.New(t)
+	ub := PostgreSQL.NewUpdateBuilder()
+	ub.Update("orders").Set(
+		"priority = " + ub.CaseValue("level").When("'vip'", 1).When("", 2).When("'normal'", ub.Col("priority")).End(),
+	)
+	sql, args := ub.Build()
+	a.Equal(sql, "UPDATE orders SET priority = CASE level WHEN 'vip' THEN $1 WHEN 'normal' THEN priority END")
+	a.Equal(args, []interface{}{1})
+
+	sb := newSelectBuilder()
+	a.Equal(sb.Case().End(), "")
+	sb.Select(sb.Case().Else(3).End())
+	sql, args = sb.Build()
+	a.Equal(sql, "SELECT ?")
+	a.Equal(args, []interface{}{3})
+}
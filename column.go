@@ -0,0 +1,208 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"strings"
+)
+
+// ColumnRef is a column in the select list of SELECT.
+type ColumnRef struct {
+	// Expr is the source expression of the column without alias, e.g. "COUNT(*)" in "COUNT(*) AS cnt".
+	Expr string
+
+	// Alias is the unquoted alias set by AS, e.g. "cnt" in "COUNT(*) AS cnt".
+	// It's empty if there is no AS in the column.
+	Alias string
+}
+
+// Name returns the name of the column in result set if it can be determined without executing the query.
+//
+// If there is an alias, it's the alias.
+// If Expr is a column name like "t.col", it's the unquoted "col".
+// Otherwise, it's the Expr itself, which is the default column name in most databases.
+func (cr ColumnRef) Name() string {
+	if cr.Alias != "" {
+		return cr.Alias
+	}
+
+	name := cr.Expr
+
+	if idx := strings.LastIndexByte(name, '.'); idx >= 0 && isColumnName(name) {
+		name = name[idx+1:]
+	}
+
+	return unquoteIdentifier(name)
+}
+
+// parseColumnRef parses "expr AS alias" in col.
+// The AS inside parentheses or quotes, e.g. "CAST(x AS INT)", is ignored.
+func parseColumnRef(col string) ColumnRef {
+	col = strings.TrimSpace(col)
+	pos := -1
+	depth := 0
+	var quote byte
+
+	for i := 0; i < len(col); i++ {
+		c := col[i]
+
+		if quote != 0 {
+			if c == quote {
+				quote = 0
+			}
+
+			continue
+		}
+
+		switch c {
+		case '\'', '"', '`':
+			quote = c
+		case '[':
+			quote = ']'
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ' ', '\t', '\n':
+			if depth == 0 && i+4 <= len(col) && strings.EqualFold(col[i+1:i+3], "AS") && isSpace(col[i+3]) {
+				pos = i
+			}
+		}
+	}
+
+	if pos < 0 {
+		return ColumnRef{
+			Expr: col,
+		}
+	}
+
+	return ColumnRef{
+		Expr:  strings.TrimSpace(col[:pos]),
+		Alias: unquoteIdentifier(strings.TrimSpace(col[pos+4:])),
+	}
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n'
+}
+
+// isColumnName returns true if s looks like a column name which may be qualified by table and quoted.
+func isColumnName(s string) bool {
+	if s == "" || s == "*" {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '_' || c == '.' || c == '"' || c == '`' || c == '[' || c == ']' || c == '*':
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func unquoteIdentifier(s string) string {
+	if len(s) < 2 {
+		return s
+	}
+
+	switch first, last := s[0], s[len(s)-1]; {
+	case first == '"' && last == '"', first == '`' && last == '`', first == '[' && last == ']':
+		return s[1 : len(s)-1]
+	}
+
+	return s
+}
+
+// aggregateFuncs is a set of well-known aggregate functions in upper case.
+var aggregateFuncs = map[string]struct{}{
+	"ANY_VALUE":      {},
+	"ARRAY_AGG":      {},
+	"AVG":            {},
+	"BIT_AND":        {},
+	"BIT_OR":         {},
+	"BIT_XOR":        {},
+	"BOOL_AND":       {},
+	"BOOL_OR":        {},
+	"COUNT":          {},
+	"COUNT_BIG":      {},
+	"EVERY":          {},
+	"GROUP_CONCAT":   {},
+	"GROUPARRAY":     {},
+	"JSON_AGG":       {},
+	"JSON_ARRAYAGG":  {},
+	"JSON_OBJECTAGG": {},
+	"JSONB_AGG":      {},
+	"LISTAGG":        {},
+	"MAX":            {},
+	"MEDIAN":         {},
+	"MIN":            {},
+	"STDDEV":         {},
+	"STDDEV_POP":     {},
+	"STDDEV_SAMP":    {},
+	"STRING_AGG":     {},
+	"SUM":            {},
+	"UNIQ":           {},
+	"UNIQEXACT":      {},
+	"VAR_POP":        {},
+	"VAR_SAMP":       {},
+	"VARIANCE":       {},
+}
+
+// isAggregateExpr returns true if expr calls any function in aggregateFuncs.
+// Function names in quoted strings or identifiers are ignored.
+func isAggregateExpr(expr string) bool {
+	var quote byte
+
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+
+		if quote != 0 {
+			if c == quote {
+				quote = 0
+			}
+
+			continue
+		}
+
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+			continue
+		case !isIdentByte(c):
+			continue
+		}
+
+		// Read the whole identifier and check whether it is called as a function.
+		start := i
+
+		for i < len(expr) && isIdentByte(expr[i]) {
+			i++
+		}
+
+		name := expr[start:i]
+		j := i
+
+		for j < len(expr) && isSpace(expr[j]) {
+			j++
+		}
+
+		if j < len(expr) && expr[j] == '(' {
+			if _, ok := aggregateFuncs[strings.ToUpper(name)]; ok {
+				return true
+			}
+		}
+
+		i--
+	}
+
+	return false
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
@@ -0,0 +1,43 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func TestParseColumnRef(t *testing.T) {
+	cases := []struct {
+		Col   string
+		Expr  string
+		Alias string
+		Name  string
+	}{
+		{"id", "id", "", "id"},
+		{"u.id", "u.id", "", "id"},
+		{"`u`.`id`", "`u`.`id`", "", "id"},
+		{"*", "*", "", "*"},
+		{"u.name AS user_name", "u.name", "user_name", "user_name"},
+		{"u.name as user_name", "u.name", "user_name", "user_name"},
+		{`COUNT(*) AS "Total Count"`, "COUNT(*)", "Total Count", "Total Count"},
+		{"CAST(x AS INT)", "CAST(x AS INT)", "", "CAST(x AS INT)"},
+		{"CAST(x AS INT) AS [x int]", "CAST(x AS INT)", "x int", "x int"},
+		{"'a AS b'", "'a AS b'", "", "'a AS b'"},
+		{"(SELECT a AS b FROM t) AS sub", "(SELECT a AS b FROM t)", "sub", "sub"},
+		{"class", "class", "", "class"},
+		{"x AS", "x AS", "", "x AS"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Col, func(t *testing.T) {
+			a := assert.New(t)
+			cr := parseColumnRef(c.Col)
+			a.Equal(cr.Expr, c.Expr)
+			a.Equal(cr.Alias, c.Alias)
+			a.Equal(cr.Name(), c.Name)
+		})
+	}
+}
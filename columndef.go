@@ -0,0 +1,375 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"strconv"
+	"strings"
+)
+
+type columnTypeKind int
+
+const (
+	columnTypeRaw columnTypeKind = iota
+	columnTypeBoolean
+	columnTypeSmallInt
+	columnTypeInt
+	columnTypeBigInt
+	columnTypeVarchar
+	columnTypeText
+	columnTypeDouble
+	columnTypeDecimal
+	columnTypeDate
+	columnTypeTimestamp
+	columnTypeBlob
+)
+
+// ColumnType is a portable column type used by `ColumnDef#Type`.
+// It's written as a flavor-specific type when building, e.g. `TypeBoolean` is written as
+// "TINYINT(1)" in MySQL and "BOOLEAN" in PostgreSQL.
+type ColumnType struct {
+	kind      columnTypeKind
+	raw       string
+	length    int
+	precision int
+	scale     int
+}
+
+// Portable column types.
+var (
+	TypeBoolean   = ColumnType{kind: columnTypeBoolean}
+	TypeSmallInt  = ColumnType{kind: columnTypeSmallInt}
+	TypeInt       = ColumnType{kind: columnTypeInt}
+	TypeBigInt    = ColumnType{kind: columnTypeBigInt}
+	TypeText      = ColumnType{kind: columnTypeText}
+	TypeDouble    = ColumnType{kind: columnTypeDouble}
+	TypeDate      = ColumnType{kind: columnTypeDate}
+	TypeTimestamp = ColumnType{kind: columnTypeTimestamp}
+	TypeBlob      = ColumnType{kind: columnTypeBlob}
+)
+
+// TypeVarchar returns a portable type of variable-length string with max length n.
+func TypeVarchar(n int) ColumnType {
+	return ColumnType{kind: columnTypeVarchar, length: n}
+}
+
+// TypeDecimal returns a portable type of exact numeric with precision and scale.
+func TypeDecimal(precision, scale int) ColumnType {
+	return ColumnType{kind: columnTypeDecimal, precision: precision, scale: scale}
+}
+
+// TypeRaw returns a type written as it is in all flavors.
+func TypeRaw(sqlType string) ColumnType {
+	return ColumnType{kind: columnTypeRaw, raw: sqlType}
+}
+
+// SQL returns the type in flavor.
+func (t ColumnType) SQL(flavor Flavor) string {
+	switch t.kind {
+	case columnTypeBoolean:
+		switch flavor {
+		case MySQL, MariaDB:
+			return "TINYINT(1)"
+		case SQLServer:
+			return "BIT"
+		case Oracle:
+			return "NUMBER(1)"
+		case ClickHouse:
+			return "Bool"
+		}
+
+		return "BOOLEAN"
+
+	case columnTypeSmallInt:
+		return t.intSQL(flavor, "SMALLINT", "NUMBER(5)", "Int16")
+
+	case columnTypeInt:
+		if flavor == SQLite {
+			return "INTEGER"
+		}
+
+		return t.intSQL(flavor, "INT", "NUMBER(10)", "Int32")
+
+	case columnTypeBigInt:
+		return t.intSQL(flavor, "BIGINT", "NUMBER(19)", "Int64")
+
+	case columnTypeVarchar:
+		switch flavor {
+		case Oracle:
+			return "VARCHAR2(" + strconv.Itoa(t.length) + ")"
+		case ClickHouse:
+			return "String"
+		case CQL:
+			return "text"
+		}
+
+		return "VARCHAR(" + strconv.Itoa(t.length) + ")"
+
+	case columnTypeText:
+		switch flavor {
+		case SQLServer:
+			return "NVARCHAR(MAX)"
+		case Oracle:
+			return "CLOB"
+		case ClickHouse:
+			return "String"
+		case CQL:
+			return "text"
+		}
+
+		return "TEXT"
+
+	case columnTypeDouble:
+		switch flavor {
+		case PostgreSQL:
+			return "DOUBLE PRECISION"
+		case SQLServer:
+			return "FLOAT"
+		case SQLite:
+			return "REAL"
+		case Oracle:
+			return "BINARY_DOUBLE"
+		case ClickHouse:
+			return "Float64"
+		}
+
+		return "DOUBLE"
+
+	case columnTypeDecimal:
+		ps := strconv.Itoa(t.precision) + ", " + strconv.Itoa(t.scale)
+
+		switch flavor {
+		case Oracle:
+			return "NUMBER(" + ps + ")"
+		case ClickHouse:
+			return "Decimal(" + ps + ")"
+		}
+
+		return "DECIMAL(" + ps + ")"
+
+	case columnTypeDate:
+		if flavor == ClickHouse {
+			return "Date"
+		}
+
+		return "DATE"
+
+	case columnTypeTimestamp:
+		switch flavor {
+		case MySQL, MariaDB:
+			return "DATETIME"
+		case SQLServer:
+			return "DATETIME2"
+		case ClickHouse:
+			return "DateTime"
+		}
+
+		return "TIMESTAMP"
+
+	case columnTypeBlob:
+		switch flavor {
+		case PostgreSQL:
+			return "BYTEA"
+		case SQLServer:
+			return "VARBINARY(MAX)"
+		case ClickHouse:
+			return "String"
+		case CQL:
+			return "blob"
+		}
+
+		return "BLOB"
+	}
+
+	return t.raw
+}
+
+func (t ColumnType) intSQL(flavor Flavor, standard, oracle, clickhouse string) string {
+	switch flavor {
+	case Oracle:
+		return oracle
+	case ClickHouse:
+		return clickhouse
+	}
+
+	return standard
+}
+
+// serialSQL returns the serial type in PostgreSQL for integer types.
+func (t ColumnType) serialSQL() string {
+	switch t.kind {
+	case columnTypeSmallInt:
+		return "SMALLSERIAL"
+	case columnTypeInt:
+		return "SERIAL"
+	case columnTypeBigInt:
+		return "BIGSERIAL"
+	}
+
+	return ""
+}
+
+// ColumnDef is a portable column definition in CREATE TABLE.
+// It's created by `CreateTableBuilder#Column` and written in the flavor of the builder.
+type ColumnDef struct {
+	name          string
+	typ           ColumnType
+	notNull       bool
+	null          bool
+	hasDefault    bool
+	defaultValue  interface{}
+	primaryKey    bool
+	unique        bool
+	autoIncrement bool
+}
+
+// Column adds a column definition in CREATE TABLE and returns it to set type and constraints.
+// Unlike `Define`, the definition is written according to the flavor when building.
+func (ctb *CreateTableBuilder) Column(name string) *ColumnDef {
+	cd := &ColumnDef{
+		name: name,
+	}
+	ctb.Define(ctb.Var(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			ctx.WriteString(cd.SQL(ctx.Flavor))
+
+			if comment := ctb.inlineColumnComment(ctx.Flavor, cd.name); comment != "" {
+				ctx.WriteString(" ")
+				ctx.WriteString(comment)
+			}
+		},
+	}))
+	return cd
+}
+
+// Type sets the column type.
+func (cd *ColumnDef) Type(t ColumnType) *ColumnDef {
+	cd.typ = t
+	return cd
+}
+
+// NotNull adds NOT NULL constraint.
+func (cd *ColumnDef) NotNull() *ColumnDef {
+	cd.notNull = true
+	cd.null = false
+	return cd
+}
+
+// Null adds NULL constraint explicitly.
+func (cd *ColumnDef) Null() *ColumnDef {
+	cd.null = true
+	cd.notNull = false
+	return cd
+}
+
+// Default sets the default value.
+// The value is interpolated as a literal by `Flavor#Interpolate`, as DDL doesn't accept bound args.
+// Use `Raw` to write an expression, e.g. `Default(Raw("CURRENT_TIMESTAMP"))`.
+// If the value cannot be interpolated, DEFAULT is omitted.
+func (cd *ColumnDef) Default(value interface{}) *ColumnDef {
+	cd.hasDefault = true
+	cd.defaultValue = value
+	return cd
+}
+
+// PrimaryKey adds PRIMARY KEY constraint.
+func (cd *ColumnDef) PrimaryKey() *ColumnDef {
+	cd.primaryKey = true
+	return cd
+}
+
+// Unique adds UNIQUE constraint.
+func (cd *ColumnDef) Unique() *ColumnDef {
+	cd.unique = true
+	return cd
+}
+
+// AutoIncrement makes the column auto-increment.
+//
+// It's written in flavor-specific way.
+//   - MySQL and MariaDB: AUTO_INCREMENT.
+//   - PostgreSQL: SMALLINT, INT and BIGINT are written as SMALLSERIAL, SERIAL and BIGSERIAL.
+//     Other types are written with GENERATED BY DEFAULT AS IDENTITY.
+//   - SQLite: AUTOINCREMENT after PRIMARY KEY. It's omitted if the column is not a primary key.
+//   - SQLServer: IDENTITY(1,1).
+//   - Oracle and ANSI: GENERATED BY DEFAULT AS IDENTITY.
+//   - Informix: SERIAL or BIGSERIAL.
+//
+// It's omitted in other flavors.
+func (cd *ColumnDef) AutoIncrement() *ColumnDef {
+	cd.autoIncrement = true
+	return cd
+}
+
+// SQL returns the column definition in flavor.
+func (cd *ColumnDef) SQL(flavor Flavor) string {
+	parts := make([]string, 0, 8)
+	parts = append(parts, cd.name)
+	typ := cd.typ.SQL(flavor)
+
+	if cd.autoIncrement {
+		switch flavor {
+		case PostgreSQL:
+			if serial := cd.typ.serialSQL(); serial != "" {
+				typ = serial
+			} else {
+				typ += " GENERATED BY DEFAULT AS IDENTITY"
+			}
+
+		case Informix:
+			if cd.typ.kind == columnTypeBigInt {
+				typ = "BIGSERIAL"
+			} else {
+				typ = "SERIAL"
+			}
+
+		case MySQL, MariaDB:
+			typ += " AUTO_INCREMENT"
+
+		case SQLServer:
+			typ += " IDENTITY(1,1)"
+
+		case Oracle, ANSI:
+			typ += " GENERATED BY DEFAULT AS IDENTITY"
+		}
+	}
+
+	if typ != "" {
+		parts = append(parts, typ)
+	}
+
+	if cd.notNull {
+		parts = append(parts, "NOT NULL")
+	} else if cd.null {
+		parts = append(parts, "NULL")
+	}
+
+	if cd.hasDefault {
+		if value, ok := literalWithFlavor(flavor, cd.defaultValue); ok {
+			parts = append(parts, "DEFAULT "+value)
+		}
+	}
+
+	if cd.unique {
+		parts = append(parts, "UNIQUE")
+	}
+
+	if cd.primaryKey {
+		parts = append(parts, "PRIMARY KEY")
+
+		if cd.autoIncrement && flavor == SQLite {
+			parts = append(parts, "AUTOINCREMENT")
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// literalWithFlavor returns value as a literal in flavor.
+// It's used in DDL which doesn't accept bound args.
+func literalWithFlavor(flavor Flavor, value interface{}) (literal string, ok bool) {
+	sql, args := Buildf("%v", value).BuildWithFlavor(flavor)
+	literal, err := flavor.Interpolate(sql, args)
+	return literal, err == nil
+}
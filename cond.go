@@ -3,6 +3,8 @@
 
 package sqlbuilder
 
+import "strings"
+
 const (
 	lparen = "("
 	rparen = ")"
@@ -35,6 +37,148 @@ func NewCond() *Cond {
 	}
 }
 
+// Clone returns a new Cond with a deep copy of c.Args.
+// Expressions built by c before cloning are valid in both c and the clone,
+// while expressions built afterwards only belong to the Cond building them.
+//
+// The Cond embedded in a builder, e.g. `SelectBuilder`, shares Args with the builder deliberately.
+// A clone of such Cond is detached from the builder, so expressions built by the clone
+// must not be used in the builder.
+func (c *Cond) Clone() *Cond {
+	if c.Args == nil {
+		return &Cond{}
+	}
+
+	return &Cond{
+		Args: c.Args.clone(),
+	}
+}
+
+// Expr creates an `Expression` which can be used as a value in all Cond methods,
+// e.g. `Like`, `Equal`, `In` and `Between`, and `UpdateBuilder#Assign`.
+// It's the same as the package-level `Expr`.
+//
+// For instance, `sb.Like("name", sb.Expr("CONCAT('%', ?, '%')", term))`
+// is compiled to "name LIKE CONCAT('%', ?, '%')" with term bound.
+func (c *Cond) Expr(format string, arg ...interface{}) Expression {
+	return Expr(format, arg...)
+}
+
+// Col marks name as a column in `Compare`.
+// It can also be used as a value in other Cond methods to compare with a column instead of a bound value.
+func (c *Cond) Col(name string) interface{} {
+	return colOperand{name: name}
+}
+
+// Value marks v as a bound value in `Compare`.
+func (c *Cond) Value(v interface{}) interface{} {
+	return valueOperand{value: v}
+}
+
+// Compare is used to construct the expression "left op right".
+//
+// By default, a string left is written as a field and right is bound as a value like other Cond methods.
+// Use `Col` and `Value` to mark operands explicitly, e.g. `cond.Compare(cond.Value(x), "=", cond.Col("y"))`
+// is compiled to "? = y".
+func (c *Cond) Compare(left interface{}, op string, right interface{}) string {
+	if len(op) == 0 {
+		return ""
+	}
+
+	if s, ok := left.(string); ok {
+		if len(s) == 0 {
+			return ""
+		}
+
+		left = colOperand{name: s}
+	}
+
+	return c.Var(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			ctx.WriteValue(left)
+			ctx.WriteString(" ")
+			ctx.WriteString(op)
+			ctx.WriteString(" ")
+			ctx.WriteValue(right)
+		},
+	})
+}
+
+// Coalesce is used to construct the expression "COALESCE(arg1, arg2, ...)".
+//
+// A string arg is written as a column and other args are bound as values.
+// Use `Col` and `Value` to mark operands explicitly, e.g. `cond.Coalesce("nickname", cond.Value("anonymous"))`
+// is compiled to "COALESCE(nickname, ?)".
+func (c *Cond) Coalesce(arg ...interface{}) string {
+	return c.funcCall("COALESCE", arg)
+}
+
+// NullIf is used to construct the expression "NULLIF(a, b)".
+// Operands are handled in the same way as `Coalesce`.
+func (c *Cond) NullIf(a, b interface{}) string {
+	return c.funcCall("NULLIF", []interface{}{a, b})
+}
+
+// Greatest is used to construct the expression "GREATEST(arg1, arg2, ...)".
+// Operands are handled in the same way as `Coalesce`.
+//
+// SQLite doesn't have GREATEST. The multi-argument MAX is used instead.
+func (c *Cond) Greatest(arg ...interface{}) string {
+	return c.funcCall("GREATEST", arg)
+}
+
+// Least is used to construct the expression "LEAST(arg1, arg2, ...)".
+// Operands are handled in the same way as `Coalesce`.
+//
+// SQLite doesn't have LEAST. The multi-argument MIN is used instead.
+func (c *Cond) Least(arg ...interface{}) string {
+	return c.funcCall("LEAST", arg)
+}
+
+func (c *Cond) funcCall(name string, arg []interface{}) string {
+	if len(arg) == 0 {
+		return ""
+	}
+
+	operands := make([]interface{}, 0, len(arg))
+
+	for _, a := range arg {
+		if s, ok := a.(string); ok {
+			a = colOperand{name: s}
+		}
+
+		operands = append(operands, a)
+	}
+
+	return c.Var(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			fn := name
+
+			if ctx.Flavor == SQLite {
+				switch name {
+				case "GREATEST":
+					fn = "MAX"
+				case "LEAST":
+					fn = "MIN"
+				}
+			}
+
+			ctx.WriteString(fn)
+			ctx.WriteString("(")
+
+			for i, operand := range operands {
+				if i > 0 {
+					ctx.WriteString(", ")
+				}
+
+				ctx.WriteValue(operand)
+			}
+
+			ctx.WriteString(")")
+		},
+	})
+}
+
 // Equal is used to construct the expression "field = value".
 func (c *Cond) Equal(field string, value interface{}) string {
 	if len(field) == 0 {
@@ -43,9 +187,27 @@ func (c *Cond) Equal(field string, value interface{}) string {
 
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
-			ctx.WriteString(field)
+			ctx.WriteField(field)
+			ctx.WriteString(" = ")
+			ctx.WriteValue(value)
+		},
+	})
+}
+
+// EqualCollate is used to construct the expression "field = value COLLATE collation".
+// The collation is quoted in PostgreSQL, e.g. `COLLATE "C"`, and written as it is in other flavors,
+// e.g. `COLLATE utf8mb4_bin` in MySQL and `COLLATE NOCASE` in SQLite.
+func (c *Cond) EqualCollate(field string, value interface{}, collation string) string {
+	if len(field) == 0 {
+		return ""
+	}
+
+	return c.Var(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			ctx.WriteField(field)
 			ctx.WriteString(" = ")
 			ctx.WriteValue(value)
+			writeCollation(ctx, collation)
 		},
 	})
 }
@@ -68,7 +230,7 @@ func (c *Cond) NotEqual(field string, value interface{}) string {
 
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
-			ctx.WriteString(field)
+			ctx.WriteField(field)
 			ctx.WriteString(" <> ")
 			ctx.WriteValue(value)
 		},
@@ -93,7 +255,7 @@ func (c *Cond) GreaterThan(field string, value interface{}) string {
 
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
-			ctx.WriteString(field)
+			ctx.WriteField(field)
 			ctx.WriteString(" > ")
 			ctx.WriteValue(value)
 		},
@@ -118,7 +280,7 @@ func (c *Cond) GreaterEqualThan(field string, value interface{}) string {
 
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
-			ctx.WriteString(field)
+			ctx.WriteField(field)
 			ctx.WriteString(" >= ")
 			ctx.WriteValue(value)
 		},
@@ -143,7 +305,7 @@ func (c *Cond) LessThan(field string, value interface{}) string {
 
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
-			ctx.WriteString(field)
+			ctx.WriteField(field)
 			ctx.WriteString(" < ")
 			ctx.WriteValue(value)
 		},
@@ -167,7 +329,7 @@ func (c *Cond) LessEqualThan(field string, value interface{}) string {
 	}
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
-			ctx.WriteString(field)
+			ctx.WriteField(field)
 			ctx.WriteString(" <= ")
 			ctx.WriteValue(value)
 		},
@@ -192,7 +354,7 @@ func (c *Cond) In(field string, values ...interface{}) string {
 
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
-			ctx.WriteString(field)
+			ctx.WriteField(field)
 			ctx.WriteString(" IN (")
 			ctx.WriteValues(values, ", ")
 			ctx.WriteString(")")
@@ -200,6 +362,103 @@ func (c *Cond) In(field string, values ...interface{}) string {
 	})
 }
 
+// InFunc is used to construct the expression "field IN (value...)" with values pulled from next.
+// The next is called repeatedly until it returns false, and all values are bound in order.
+// If next yields nothing, InFunc returns "0 = 1" which is always false.
+func (c *Cond) InFunc(field string, next func() (value interface{}, ok bool)) string {
+	if len(field) == 0 || next == nil {
+		return ""
+	}
+
+	var values []interface{}
+
+	for v, ok := next(); ok; v, ok = next() {
+		values = append(values, v)
+	}
+
+	if len(values) == 0 {
+		return "0 = 1"
+	}
+
+	// The slice is passed to In as it is without copying values.
+	return c.In(field, values...)
+}
+
+// InArray is used to construct the expression "field = ANY(values)" in PostgreSQL
+// or "field IN (value...)" in other flavors.
+//
+// In PostgreSQL, values is bound as one array arg so that the number of placeholders
+// doesn't grow with values. The driver must be able to bind values as an array,
+// e.g. pgx accepts slices directly and lib/pq requires `pq.Array(values)`.
+// In other flavors, values is flattened by `Flatten` and every element is bound.
+// If values is empty, it's written as "0 = 1" which is always false, as "field IN ()" is invalid.
+func (c *Cond) InArray(field string, values interface{}) string {
+	if len(field) == 0 {
+		return ""
+	}
+
+	return c.Var(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			if ctx.Flavor == PostgreSQL {
+				ctx.WriteField(field)
+				ctx.WriteString(" = ANY(")
+				ctx.WriteValue(values)
+				ctx.WriteString(")")
+				return
+			}
+
+			flattened := Flatten(values)
+
+			if len(flattened) == 0 {
+				ctx.WriteString("0 = 1")
+				return
+			}
+
+			ctx.WriteField(field)
+			ctx.WriteString(" IN (")
+			ctx.WriteValues(flattened, ", ")
+			ctx.WriteString(")")
+		},
+	})
+}
+
+// InQuery is used to construct the expression "field IN (subquery)".
+// Unlike `In`, the subquery is always written as a subquery and args in it are merged.
+func (c *Cond) InQuery(field string, subquery Builder) string {
+	return c.inQuery(field, " IN (", subquery)
+}
+
+// NotInQuery is used to construct the expression "field NOT IN (subquery)".
+// Unlike `NotIn`, the subquery is always written as a subquery and args in it are merged.
+func (c *Cond) NotInQuery(field string, subquery Builder) string {
+	return c.inQuery(field, " NOT IN (", subquery)
+}
+
+// TupleInQuery is used to construct the expression "(col1, col2, ...) IN (subquery)".
+// The cols are formatted by `TupleNames` and not escaped.
+func (c *Cond) TupleInQuery(cols []string, subquery Builder) string {
+	if len(cols) == 0 {
+		return ""
+	}
+
+	return c.inQuery(TupleNames(cols...), " IN (", subquery)
+}
+
+func (c *Cond) inQuery(field, op string, subquery Builder) string {
+	if len(field) == 0 || subquery == nil {
+		return ""
+	}
+
+	return c.Var(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			ctx.WriteField(field)
+			ctx.WriteString(op)
+			ctx.WriteValue(subquery)
+			ctx.WriteString(")")
+		},
+	})
+}
+
 // NotIn is used to construct the expression "field NOT IN (value...)".
 func (c *Cond) NotIn(field string, values ...interface{}) string {
 	if len(field) == 0 {
@@ -208,7 +467,7 @@ func (c *Cond) NotIn(field string, values ...interface{}) string {
 
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
-			ctx.WriteString(field)
+			ctx.WriteField(field)
 			ctx.WriteString(" NOT IN (")
 			ctx.WriteValues(values, ", ")
 			ctx.WriteString(")")
@@ -224,13 +483,136 @@ func (c *Cond) Like(field string, value interface{}) string {
 
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
-			ctx.WriteString(field)
+			ctx.WriteField(field)
+			ctx.WriteString(" LIKE ")
+			ctx.WriteValue(value)
+		},
+	})
+}
+
+// AnyLike is used to construct the expression "(field1 LIKE value OR field2 LIKE value ...)".
+// It's useful to search a term in several columns.
+//
+// The value is bound once per field, so there are as many placeholders as fields in the expression.
+// Empty fields are ignored.
+func (c *Cond) AnyLike(fields []string, value interface{}) string {
+	exprs := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		if len(field) == 0 {
+			continue
+		}
+
+		exprs = append(exprs, c.Like(field, value))
+	}
+
+	return c.Or(exprs...)
+}
+
+// LikeContains is used to construct the expression "field LIKE '%term%' ESCAPE '\'".
+//
+// The term is a raw search term rather than a pattern.
+// All LIKE metacharacters in term are escaped by `EscapeLikePattern`.
+func (c *Cond) LikeContains(field string, term string) string {
+	return c.likeEscaped(field, "%"+EscapeLikePattern(term)+"%")
+}
+
+// LikePrefix is used to construct the expression "field LIKE 'term%' ESCAPE '\'".
+//
+// The term is a raw search term rather than a pattern.
+// All LIKE metacharacters in term are escaped by `EscapeLikePattern`.
+func (c *Cond) LikePrefix(field string, term string) string {
+	return c.likeEscaped(field, EscapeLikePattern(term)+"%")
+}
+
+// LikeSuffix is used to construct the expression "field LIKE '%term' ESCAPE '\'".
+//
+// The term is a raw search term rather than a pattern.
+// All LIKE metacharacters in term are escaped by `EscapeLikePattern`.
+func (c *Cond) LikeSuffix(field string, term string) string {
+	return c.likeEscaped(field, "%"+EscapeLikePattern(term))
+}
+
+func (c *Cond) likeEscaped(field string, pattern string) string {
+	if len(field) == 0 {
+		return ""
+	}
+
+	return c.Var(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			ctx.WriteField(field)
 			ctx.WriteString(" LIKE ")
+			ctx.WriteValue(pattern)
+			writeLikeEscape(ctx)
+		},
+	})
+}
+
+// LikeWithEscape is used to construct the expression "field LIKE value ESCAPE 'escape'".
+// The escape character is quoted as a string literal for the flavor.
+// In CQL, the ESCAPE clause is not written as it's not supported.
+func (c *Cond) LikeWithEscape(field string, value interface{}, escape rune) string {
+	return c.likeWithEscape(field, " LIKE ", value, escape)
+}
+
+// NotLikeWithEscape is used to construct the expression "field NOT LIKE value ESCAPE 'escape'".
+// The escape character is quoted as a string literal for the flavor.
+// In CQL, the ESCAPE clause is not written as it's not supported.
+func (c *Cond) NotLikeWithEscape(field string, value interface{}, escape rune) string {
+	return c.likeWithEscape(field, " NOT LIKE ", value, escape)
+}
+
+func (c *Cond) likeWithEscape(field, op string, value interface{}, escape rune) string {
+	if len(field) == 0 {
+		return ""
+	}
+
+	return c.Var(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			ctx.WriteField(field)
+			ctx.WriteString(op)
 			ctx.WriteValue(value)
+			writeLikeEscapeChar(ctx, escape)
 		},
 	})
 }
 
+// writeLikeEscape writes the ESCAPE clause to declare backslash as the escape character.
+func writeLikeEscape(ctx *argsCompileContext) {
+	writeLikeEscapeChar(ctx, '\\')
+}
+
+// writeLikeEscapeChar writes the ESCAPE clause to declare escape as the escape character.
+func writeLikeEscapeChar(ctx *argsCompileContext, escape rune) {
+	switch ctx.Flavor {
+	case CQL:
+		// CQL doesn't support ESCAPE.
+		return
+
+	case ClickHouse:
+		// ClickHouse uses backslash as the escape character and doesn't support ESCAPE.
+		if escape == '\\' {
+			return
+		}
+	}
+
+	ctx.WriteString(" ESCAPE '")
+
+	switch {
+	case escape == '\'':
+		ctx.WriteString("''")
+
+	case escape == '\\' && ctx.Flavor.isMySQLCompatible():
+		// Backslash must be escaped in MySQL string literal.
+		ctx.WriteString(`\\`)
+
+	default:
+		ctx.WriteRune(escape)
+	}
+
+	ctx.WriteString("'")
+}
+
 // ILike is used to construct the expression "field ILIKE value".
 //
 // When the database system does not support the ILIKE operator,
@@ -244,15 +626,15 @@ func (c *Cond) ILike(field string, value interface{}) string {
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
 			switch ctx.Flavor {
-			case PostgreSQL, SQLite:
-				ctx.WriteString(field)
+			case PostgreSQL, SQLite, DuckDB:
+				ctx.WriteField(field)
 				ctx.WriteString(" ILIKE ")
 				ctx.WriteValue(value)
 
 			default:
 				// Use LOWER to simulate ILIKE.
 				ctx.WriteString("LOWER(")
-				ctx.WriteString(field)
+				ctx.WriteField(field)
 				ctx.WriteString(") LIKE LOWER(")
 				ctx.WriteValue(value)
 				ctx.WriteString(")")
@@ -269,7 +651,7 @@ func (c *Cond) NotLike(field string, value interface{}) string {
 
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
-			ctx.WriteString(field)
+			ctx.WriteField(field)
 			ctx.WriteString(" NOT LIKE ")
 			ctx.WriteValue(value)
 		},
@@ -289,15 +671,15 @@ func (c *Cond) NotILike(field string, value interface{}) string {
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
 			switch ctx.Flavor {
-			case PostgreSQL, SQLite:
-				ctx.WriteString(field)
+			case PostgreSQL, SQLite, DuckDB:
+				ctx.WriteField(field)
 				ctx.WriteString(" NOT ILIKE ")
 				ctx.WriteValue(value)
 
 			default:
 				// Use LOWER to simulate ILIKE.
 				ctx.WriteString("LOWER(")
-				ctx.WriteString(field)
+				ctx.WriteField(field)
 				ctx.WriteString(") NOT LIKE LOWER(")
 				ctx.WriteValue(value)
 				ctx.WriteString(")")
@@ -306,6 +688,307 @@ func (c *Cond) NotILike(field string, value interface{}) string {
 	})
 }
 
+// RegexpMatch is used to construct the expression "field REGEXP value"
+// which is true if field matches the regular expression value.
+//
+// The operator depends on the flavor, e.g. "field ~ value" in PostgreSQL,
+// "match(field, value)" in ClickHouse and "REGEXP_LIKE(field, value)" in Oracle and SQLServer.
+func (c *Cond) RegexpMatch(field string, value interface{}) string {
+	return c.regexpMatch(field, value, false, false)
+}
+
+// RegexpIMatch is the case-insensitive version of `RegexpMatch`.
+//
+// The operator depends on the flavor, e.g. "field ~* value" in PostgreSQL,
+// "REGEXP_LIKE(field, value, 'i')" in MySQL, Oracle and SQLServer.
+// In SQLite, ClickHouse and Presto, "(?i)" is prepended to value as they don't support match flags.
+func (c *Cond) RegexpIMatch(field string, value interface{}) string {
+	return c.regexpMatch(field, value, false, true)
+}
+
+// NotRegexpMatch is used to construct the expression "field NOT REGEXP value".
+// See doc in `RegexpMatch` for operators in different flavors.
+func (c *Cond) NotRegexpMatch(field string, value interface{}) string {
+	return c.regexpMatch(field, value, true, false)
+}
+
+func (c *Cond) regexpMatch(field string, value interface{}, not, caseInsensitive bool) string {
+	if len(field) == 0 {
+		return ""
+	}
+
+	return c.Var(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			switch ctx.Flavor {
+			case PostgreSQL:
+				ctx.WriteField(field)
+
+				if not {
+					ctx.WriteString(" !~")
+				} else {
+					ctx.WriteString(" ~")
+				}
+
+				if caseInsensitive {
+					ctx.WriteString("* ")
+				} else {
+					ctx.WriteString(" ")
+				}
+
+				ctx.WriteValue(value)
+
+			case MariaDB:
+				ctx.WriteField(field)
+
+				if not {
+					ctx.WriteString(" NOT")
+				}
+
+				ctx.WriteString(" REGEXP ")
+
+				if caseInsensitive {
+					ctx.WriteString("CONCAT('(?i)', ")
+					ctx.WriteValue(value)
+					ctx.WriteString(")")
+				} else {
+					ctx.WriteValue(value)
+				}
+
+			case MySQL, SQLite:
+				if caseInsensitive && ctx.Flavor == MySQL {
+					// MySQL supports match flags in REGEXP_LIKE.
+					writeRegexpLike(ctx, field, value, not, caseInsensitive)
+					return
+				}
+
+				ctx.WriteField(field)
+
+				if not {
+					ctx.WriteString(" NOT")
+				}
+
+				ctx.WriteString(" REGEXP ")
+
+				if caseInsensitive {
+					ctx.WriteString("'(?i)' || ")
+				}
+
+				ctx.WriteValue(value)
+
+			case ClickHouse, Presto:
+				writeNot(ctx, not)
+
+				if ctx.Flavor == ClickHouse {
+					ctx.WriteString("match(")
+				} else {
+					ctx.WriteString("regexp_like(")
+				}
+
+				ctx.WriteField(field)
+				ctx.WriteString(", ")
+
+				if caseInsensitive {
+					ctx.WriteString("concat('(?i)', ")
+					ctx.WriteValue(value)
+					ctx.WriteString(")")
+				} else {
+					ctx.WriteValue(value)
+				}
+
+				ctx.WriteString(")")
+
+			default:
+				writeRegexpLike(ctx, field, value, not, caseInsensitive)
+			}
+		},
+	})
+}
+
+func writeRegexpLike(ctx *argsCompileContext, field string, value interface{}, not, caseInsensitive bool) {
+	writeNot(ctx, not)
+	ctx.WriteString("REGEXP_LIKE(")
+	ctx.WriteField(field)
+	ctx.WriteString(", ")
+	ctx.WriteValue(value)
+
+	if caseInsensitive {
+		ctx.WriteString(", 'i'")
+	}
+
+	ctx.WriteString(")")
+}
+
+func writeNot(ctx *argsCompileContext, not bool) {
+	if not {
+		ctx.WriteString("NOT ")
+	}
+}
+
+// JSONExtract returns an expression extracting the value at path in the JSON field as text.
+// The path is a list of keys separated by dots, e.g. "address.city".
+// The returned expression can be used as a field in other Cond methods.
+//
+// The expression is written in the flavor used to build the SQL, e.g. "field->>'key'" or "field#>>'{a,b}'" in PostgreSQL,
+// "field->>'$.a.b'" in MySQL and SQLite, "JSON_UNQUOTE(JSON_EXTRACT(field, '$.a.b'))" in MariaDB
+// which doesn't support "->>", and "JSON_VALUE(field, '$.a.b')" in SQLServer and Oracle.
+// As the path is written as a string literal in expression, it must not be an user input.
+func (c *Cond) JSONExtract(field, path string) string {
+	if len(field) == 0 {
+		return ""
+	}
+
+	keys := strings.Split(path, ".")
+
+	return c.Var(fieldExpr{
+		Builder: func(ctx *argsCompileContext) {
+			flavor := ctx.Flavor
+
+			switch flavor {
+			case PostgreSQL:
+				ctx.WriteField(field)
+
+				if len(keys) == 1 {
+					ctx.WriteString("->>")
+					ctx.WriteString(quoteStringLiteral(flavor, path))
+					return
+				}
+
+				ctx.WriteString("#>>")
+				ctx.WriteString(quoteStringLiteral(flavor, "{"+strings.Join(keys, ",")+"}"))
+
+			case MySQL, MariaDB:
+				if flavor == MariaDB {
+					ctx.WriteString("JSON_UNQUOTE(JSON_EXTRACT(")
+					ctx.WriteField(field)
+					ctx.WriteString(", ")
+					ctx.WriteString(quoteStringLiteral(flavor, "$."+path))
+					ctx.WriteString("))")
+					return
+				}
+
+				fallthrough
+
+			case SQLite, DuckDB:
+				ctx.WriteField(field)
+				ctx.WriteString("->>")
+				ctx.WriteString(quoteStringLiteral(flavor, "$."+path))
+
+			case ClickHouse:
+				ctx.WriteString("JSONExtractString(")
+				ctx.WriteField(field)
+				ctx.WriteString(", ")
+				ctx.WriteStrings(quoteStringLiterals(flavor, keys), ", ")
+				ctx.WriteString(")")
+
+			case Presto:
+				ctx.WriteString("json_extract_scalar(")
+				ctx.WriteField(field)
+				ctx.WriteString(", ")
+				ctx.WriteString(quoteStringLiteral(flavor, "$."+path))
+				ctx.WriteString(")")
+
+			default:
+				ctx.WriteString("JSON_VALUE(")
+				ctx.WriteField(field)
+				ctx.WriteString(", ")
+				ctx.WriteString(quoteStringLiteral(flavor, "$."+path))
+				ctx.WriteString(")")
+			}
+		},
+	})
+}
+
+// JSONContains is used to construct the expression "field @> value" in PostgreSQL
+// or "JSON_CONTAINS(field, value)" in other flavors.
+// It's true if the JSON field contains the JSON value.
+func (c *Cond) JSONContains(field string, value interface{}) string {
+	if len(field) == 0 {
+		return ""
+	}
+
+	return c.Var(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			if ctx.Flavor == PostgreSQL {
+				ctx.WriteField(field)
+				ctx.WriteString(" @> ")
+				ctx.WriteValue(value)
+				return
+			}
+
+			ctx.WriteString("JSON_CONTAINS(")
+			ctx.WriteField(field)
+			ctx.WriteString(", ")
+			ctx.WriteValue(value)
+			ctx.WriteString(")")
+		},
+	})
+}
+
+// JSONHasKey is used to construct the expression "field ? 'key'" in PostgreSQL
+// which is true if key is a top-level key in the JSON field.
+//
+// In other flavors, it's written as "JSON_CONTAINS_PATH(field, 'one', '$.key')" in MySQL,
+// "json_type(field, '$.key') IS NOT NULL" in SQLite and "JSON_EXISTS(field, '$.key')" in others.
+// As the key is written as a string literal in expression, it must not be an user input.
+func (c *Cond) JSONHasKey(field, key string) string {
+	if len(field) == 0 {
+		return ""
+	}
+
+	return c.Var(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			switch ctx.Flavor {
+			case PostgreSQL:
+				ctx.WriteField(field)
+				ctx.WriteString(" ? ")
+				ctx.WriteString(quoteStringLiteral(ctx.Flavor, key))
+
+			case MySQL, MariaDB:
+				ctx.WriteString("JSON_CONTAINS_PATH(")
+				ctx.WriteField(field)
+				ctx.WriteString(", 'one', ")
+				ctx.WriteString(quoteStringLiteral(ctx.Flavor, "$."+key))
+				ctx.WriteString(")")
+
+			case SQLite:
+				ctx.WriteString("json_type(")
+				ctx.WriteField(field)
+				ctx.WriteString(", ")
+				ctx.WriteString(quoteStringLiteral(ctx.Flavor, "$."+key))
+				ctx.WriteString(") IS NOT NULL")
+
+			default:
+				ctx.WriteString("JSON_EXISTS(")
+				ctx.WriteField(field)
+				ctx.WriteString(", ")
+				ctx.WriteString(quoteStringLiteral(ctx.Flavor, "$."+key))
+				ctx.WriteString(")")
+			}
+		},
+	})
+}
+
+// quoteStringLiteral quotes s as a string literal in flavor.
+func quoteStringLiteral(flavor Flavor, s string) string {
+	s = strings.ReplaceAll(s, "'", "''")
+
+	if flavor.isMySQLCompatible() || flavor == ClickHouse {
+		s = strings.ReplaceAll(s, `\`, `\\`)
+	}
+
+	return "'" + s + "'"
+}
+
+func quoteStringLiterals(flavor Flavor, strs []string) []string {
+	quoted := make([]string, 0, len(strs))
+
+	for _, s := range strs {
+		quoted = append(quoted, quoteStringLiteral(flavor, s))
+	}
+
+	return quoted
+}
+
 // IsNull is used to construct the expression "field IS NULL".
 func (c *Cond) IsNull(field string) string {
 	if len(field) == 0 {
@@ -314,7 +997,7 @@ func (c *Cond) IsNull(field string) string {
 
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
-			ctx.WriteString(field)
+			ctx.WriteField(field)
 			ctx.WriteString(" IS NULL")
 		},
 	})
@@ -327,13 +1010,52 @@ func (c *Cond) IsNotNull(field string) string {
 	}
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
-			ctx.WriteString(field)
+			ctx.WriteField(field)
 			ctx.WriteString(" IS NOT NULL")
 		},
 	})
 }
 
+// AllNull is used to construct the expression "(field1 IS NULL AND field2 IS NULL ...)".
+// Empty fields are ignored.
+func (c *Cond) AllNull(fields ...string) string {
+	return c.And(c.eachField(fields, c.IsNull)...)
+}
+
+// AnyNull is used to construct the expression "(field1 IS NULL OR field2 IS NULL ...)".
+// Empty fields are ignored.
+func (c *Cond) AnyNull(fields ...string) string {
+	return c.Or(c.eachField(fields, c.IsNull)...)
+}
+
+// AllNotNull is used to construct the expression "(field1 IS NOT NULL AND field2 IS NOT NULL ...)".
+// Empty fields are ignored.
+func (c *Cond) AllNotNull(fields ...string) string {
+	return c.And(c.eachField(fields, c.IsNotNull)...)
+}
+
+// AnyNotNull is used to construct the expression "(field1 IS NOT NULL OR field2 IS NOT NULL ...)".
+// Empty fields are ignored.
+func (c *Cond) AnyNotNull(fields ...string) string {
+	return c.Or(c.eachField(fields, c.IsNotNull)...)
+}
+
+func (c *Cond) eachField(fields []string, fn func(field string) string) []string {
+	exprs := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		if len(field) == 0 {
+			continue
+		}
+
+		exprs = append(exprs, fn(field))
+	}
+
+	return exprs
+}
+
 // Between is used to construct the expression "field BETWEEN lower AND upper".
+// Either bound can be a `Raw` expression or a `sql.NamedArg`, e.g. `Raw("NOW()")`, which is written inline.
 func (c *Cond) Between(field string, lower, upper interface{}) string {
 	if len(field) == 0 {
 		return ""
@@ -341,7 +1063,7 @@ func (c *Cond) Between(field string, lower, upper interface{}) string {
 
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
-			ctx.WriteString(field)
+			ctx.WriteField(field)
 			ctx.WriteString(" BETWEEN ")
 			ctx.WriteValue(lower)
 			ctx.WriteString(" AND ")
@@ -351,6 +1073,7 @@ func (c *Cond) Between(field string, lower, upper interface{}) string {
 }
 
 // NotBetween is used to construct the expression "field NOT BETWEEN lower AND upper".
+// Either bound can be a `Raw` expression or a `sql.NamedArg`, e.g. `Raw("NOW()")`, which is written inline.
 func (c *Cond) NotBetween(field string, lower, upper interface{}) string {
 	if len(field) == 0 {
 		return ""
@@ -358,7 +1081,7 @@ func (c *Cond) NotBetween(field string, lower, upper interface{}) string {
 
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
-			ctx.WriteString(field)
+			ctx.WriteField(field)
 			ctx.WriteString(" NOT BETWEEN ")
 			ctx.WriteValue(lower)
 			ctx.WriteString(" AND ")
@@ -367,6 +1090,26 @@ func (c *Cond) NotBetween(field string, lower, upper interface{}) string {
 	})
 }
 
+// BetweenCols is used to construct the expression "field BETWEEN lowerCol AND upperCol".
+// Unlike `Between`, both bounds are columns and no value is bound.
+func (c *Cond) BetweenCols(field, lowerCol, upperCol string) string {
+	if len(lowerCol) == 0 || len(upperCol) == 0 {
+		return ""
+	}
+
+	return c.Between(field, c.Col(lowerCol), c.Col(upperCol))
+}
+
+// NotBetweenCols is used to construct the expression "field NOT BETWEEN lowerCol AND upperCol".
+// Unlike `NotBetween`, both bounds are columns and no value is bound.
+func (c *Cond) NotBetweenCols(field, lowerCol, upperCol string) string {
+	if len(lowerCol) == 0 || len(upperCol) == 0 {
+		return ""
+	}
+
+	return c.NotBetween(field, c.Col(lowerCol), c.Col(upperCol))
+}
+
 // Or is used to construct the expression OR logic like "expr1 OR expr2 OR expr3".
 func (c *Cond) Or(orExpr ...string) string {
 	if len(orExpr) == 0 {
@@ -414,21 +1157,68 @@ func (c *Cond) And(andExpr ...string) string {
 }
 
 // Not is used to construct the expression "NOT expr".
+//
+// If notExpr contains AND, OR or XOR outside of parentheses and quoted strings,
+// e.g. "a = 1 OR b = 2", it's wrapped in parentheses as "NOT (a = 1 OR b = 2)"
+// to negate the whole expression.
+// Expressions built by `Cond#And` and `Cond#Or` are already parenthesized.
 func (c *Cond) Not(notExpr string) string {
 	if len(notExpr) == 0 {
 		return ""
 	}
 	buf := newStringBuilder()
+	paren := hasTopLevelLogicalOperator(notExpr)
 
 	// Ensure that there is only 1 memory allocation.
 	size := len(opNOT) + len(notExpr)
-	buf.Grow(size)
 
+	if paren {
+		size += len(lparen) + len(rparen)
+	}
+
+	buf.Grow(size)
 	buf.WriteString(opNOT)
-	buf.WriteString(notExpr)
+
+	if paren {
+		buf.WriteString(lparen)
+		buf.WriteString(notExpr)
+		buf.WriteString(rparen)
+	} else {
+		buf.WriteString(notExpr)
+	}
+
 	return buf.String()
 }
 
+// hasTopLevelLogicalOperator checks whether expr contains AND, OR or XOR
+// outside of parentheses, quoted strings and comments.
+func hasTopLevelLogicalOperator(expr string) bool {
+	depth := 0
+
+	for _, token := range tokenizeForFormat(expr) {
+		switch token.kind {
+		case formatTokenPunct:
+			switch token.text {
+			case "(":
+				depth++
+			case ")":
+				depth--
+			}
+
+		case formatTokenWord:
+			if depth != 0 {
+				continue
+			}
+
+			if strings.EqualFold(token.text, "AND") || strings.EqualFold(token.text, "OR") || strings.EqualFold(token.text, "XOR") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // Exists is used to construct the expression "EXISTS (subquery)".
 func (c *Cond) Exists(subquery interface{}) string {
 	return c.Var(condBuilder{
@@ -451,6 +1241,33 @@ func (c *Cond) NotExists(subquery interface{}) string {
 	})
 }
 
+// ExistsQuery is used to construct the expression "EXISTS (subquery)".
+// Unlike `Exists`, subquery must be a `Builder` and it's always wrapped by exactly one pair of parentheses.
+// It's useful to build a correlated subquery, e.g. "EXISTS (SELECT 1 FROM t2 WHERE t2.id = t1.id)".
+func (c *Cond) ExistsQuery(subquery Builder) string {
+	return c.existsQuery("EXISTS (", subquery)
+}
+
+// NotExistsQuery is used to construct the expression "NOT EXISTS (subquery)".
+// See `ExistsQuery` for details.
+func (c *Cond) NotExistsQuery(subquery Builder) string {
+	return c.existsQuery("NOT EXISTS (", subquery)
+}
+
+func (c *Cond) existsQuery(op string, subquery Builder) string {
+	if subquery == nil {
+		return ""
+	}
+
+	return c.Var(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			ctx.WriteString(op)
+			ctx.WriteValue(subquery)
+			ctx.WriteString(")")
+		},
+	})
+}
+
 // Any is used to construct the expression "field op ANY (value...)".
 func (c *Cond) Any(field, op string, values ...interface{}) string {
 	if len(field) == 0 || len(op) == 0 {
@@ -459,7 +1276,7 @@ func (c *Cond) Any(field, op string, values ...interface{}) string {
 
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
-			ctx.WriteString(field)
+			ctx.WriteField(field)
 			ctx.WriteString(" ")
 			ctx.WriteString(op)
 			ctx.WriteString(" ANY (")
@@ -477,7 +1294,7 @@ func (c *Cond) All(field, op string, values ...interface{}) string {
 
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
-			ctx.WriteString(field)
+			ctx.WriteField(field)
 			ctx.WriteString(" ")
 			ctx.WriteString(op)
 			ctx.WriteString(" ALL (")
@@ -495,7 +1312,7 @@ func (c *Cond) Some(field, op string, values ...interface{}) string {
 
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
-			ctx.WriteString(field)
+			ctx.WriteField(field)
 			ctx.WriteString(" ")
 			ctx.WriteString(op)
 			ctx.WriteString(" SOME (")
@@ -505,6 +1322,32 @@ func (c *Cond) Some(field, op string, values ...interface{}) string {
 	})
 }
 
+// AnyQuery is used to construct the expression "field op ANY (subquery)".
+// Unlike `Any`, the subquery is always written as a subquery and args in it are merged.
+func (c *Cond) AnyQuery(field, op string, subquery Builder) string {
+	return c.quantifiedQuery(field, op, "ANY", subquery)
+}
+
+// AllQuery is used to construct the expression "field op ALL (subquery)".
+// Unlike `All`, the subquery is always written as a subquery and args in it are merged.
+func (c *Cond) AllQuery(field, op string, subquery Builder) string {
+	return c.quantifiedQuery(field, op, "ALL", subquery)
+}
+
+// SomeQuery is used to construct the expression "field op SOME (subquery)".
+// Unlike `Some`, the subquery is always written as a subquery and args in it are merged.
+func (c *Cond) SomeQuery(field, op string, subquery Builder) string {
+	return c.quantifiedQuery(field, op, "SOME", subquery)
+}
+
+func (c *Cond) quantifiedQuery(field, op, quantifier string, subquery Builder) string {
+	if len(op) == 0 {
+		return ""
+	}
+
+	return c.inQuery(field, " "+op+" "+quantifier+" (", subquery)
+}
+
 // IsDistinctFrom is used to construct the expression "field IS DISTINCT FROM value".
 //
 // When the database system does not support the IS DISTINCT FROM operator,
@@ -519,14 +1362,14 @@ func (c *Cond) IsDistinctFrom(field string, value interface{}) string {
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
 			switch ctx.Flavor {
-			case PostgreSQL, SQLite, SQLServer:
-				ctx.WriteString(field)
+			case PostgreSQL, SQLite, SQLServer, DuckDB:
+				ctx.WriteField(field)
 				ctx.WriteString(" IS DISTINCT FROM ")
 				ctx.WriteValue(value)
 
-			case MySQL:
+			case MySQL, MariaDB:
 				ctx.WriteString("NOT ")
-				ctx.WriteString(field)
+				ctx.WriteField(field)
 				ctx.WriteString(" <=> ")
 				ctx.WriteValue(value)
 
@@ -537,15 +1380,15 @@ func (c *Cond) IsDistinctFrom(field string, value interface{}) string {
 				//     ELSE 1
 				// END = 1
 				ctx.WriteString("CASE WHEN ")
-				ctx.WriteString(field)
+				ctx.WriteField(field)
 				ctx.WriteString(" IS NULL AND ")
 				ctx.WriteValue(value)
 				ctx.WriteString(" IS NULL THEN 0 WHEN ")
-				ctx.WriteString(field)
+				ctx.WriteField(field)
 				ctx.WriteString(" IS NOT NULL AND ")
 				ctx.WriteValue(value)
 				ctx.WriteString(" IS NOT NULL AND ")
-				ctx.WriteString(field)
+				ctx.WriteField(field)
 				ctx.WriteString(" = ")
 				ctx.WriteValue(value)
 				ctx.WriteString(" THEN 0 ELSE 1 END = 1")
@@ -568,13 +1411,13 @@ func (c *Cond) IsNotDistinctFrom(field string, value interface{}) string {
 	return c.Var(condBuilder{
 		Builder: func(ctx *argsCompileContext) {
 			switch ctx.Flavor {
-			case PostgreSQL, SQLite, SQLServer:
-				ctx.WriteString(field)
+			case PostgreSQL, SQLite, SQLServer, DuckDB:
+				ctx.WriteField(field)
 				ctx.WriteString(" IS NOT DISTINCT FROM ")
 				ctx.WriteValue(value)
 
-			case MySQL:
-				ctx.WriteString(field)
+			case MySQL, MariaDB:
+				ctx.WriteField(field)
 				ctx.WriteString(" <=> ")
 				ctx.WriteValue(value)
 
@@ -585,15 +1428,15 @@ func (c *Cond) IsNotDistinctFrom(field string, value interface{}) string {
 				//     ELSE 0
 				// END = 1
 				ctx.WriteString("CASE WHEN ")
-				ctx.WriteString(field)
+				ctx.WriteField(field)
 				ctx.WriteString(" IS NULL AND ")
 				ctx.WriteValue(value)
 				ctx.WriteString(" IS NULL THEN 1 WHEN ")
-				ctx.WriteString(field)
+				ctx.WriteField(field)
 				ctx.WriteString(" IS NOT NULL AND ")
 				ctx.WriteValue(value)
 				ctx.WriteString(" IS NOT NULL AND ")
-				ctx.WriteString(field)
+				ctx.WriteField(field)
 				ctx.WriteString(" = ")
 				ctx.WriteValue(value)
 				ctx.WriteString(" THEN 1 ELSE 0 END = 1")
@@ -602,11 +1445,65 @@ func (c *Cond) IsNotDistinctFrom(field string, value interface{}) string {
 	})
 }
 
+// MatchMode is the search modifier of MATCH ... AGAINST in MySQL.
+type MatchMode string
+
+// Match modes.
+const (
+	NaturalLanguage    MatchMode = "IN NATURAL LANGUAGE MODE"
+	Boolean            MatchMode = "IN BOOLEAN MODE"
+	WithQueryExpansion MatchMode = "WITH QUERY EXPANSION"
+)
+
+// Match is used to construct the MySQL full-text search expression
+// "MATCH (col1, col2, ...) AGAINST (value mode)".
+// If mode is empty, no search modifier is written and MySQL uses the natural language mode.
+// Empty cols are ignored.
+func (c *Cond) Match(cols []string, value interface{}, mode MatchMode) string {
+	cols = c.eachField(cols, func(col string) string { return col })
+
+	if len(cols) == 0 {
+		return ""
+	}
+
+	return c.Var(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			ctx.WriteString("MATCH (")
+			ctx.WriteStrings(cols, ", ")
+			ctx.WriteString(") AGAINST (")
+			ctx.WriteValue(value)
+
+			if mode != "" {
+				ctx.WriteString(" ")
+				ctx.WriteString(string(mode))
+			}
+
+			ctx.WriteString(")")
+		},
+	})
+}
+
 // Var returns a placeholder for value.
 func (c *Cond) Var(value interface{}) string {
 	return c.Args.Add(value)
 }
 
+// writeCollation writes COLLATE clause with collation in the flavor of ctx.
+func writeCollation(ctx *argsCompileContext, collation string) {
+	if collation == "" {
+		return
+	}
+
+	ctx.WriteString(" COLLATE ")
+
+	if ctx.Flavor == PostgreSQL {
+		ctx.WriteString(ctx.Flavor.Quote(collation))
+		return
+	}
+
+	ctx.WriteString(collation)
+}
+
 type condBuilder struct {
 	Builder func(ctx *argsCompileContext)
 }
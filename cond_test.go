@@ -4,6 +4,7 @@
 package sqlbuilder
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
@@ -18,43 +19,58 @@ type TestPair struct {
 func TestCond(t *testing.T) {
 	a := assert.New(t)
 	cases := map[string]func(cond *Cond) string{
-		"$a = $1":                    func(cond *Cond) string { return cond.Equal("$a", 123) },
-		"$b = $1":                    func(cond *Cond) string { return cond.E("$b", 123) },
-		"$c = $1":                    func(cond *Cond) string { return cond.EQ("$c", 123) },
-		"$a <> $1":                   func(cond *Cond) string { return cond.NotEqual("$a", 123) },
-		"$b <> $1":                   func(cond *Cond) string { return cond.NE("$b", 123) },
-		"$c <> $1":                   func(cond *Cond) string { return cond.NEQ("$c", 123) },
-		"$a > $1":                    func(cond *Cond) string { return cond.GreaterThan("$a", 123) },
-		"$b > $1":                    func(cond *Cond) string { return cond.G("$b", 123) },
-		"$c > $1":                    func(cond *Cond) string { return cond.GT("$c", 123) },
-		"$a >= $1":                   func(cond *Cond) string { return cond.GreaterEqualThan("$a", 123) },
-		"$b >= $1":                   func(cond *Cond) string { return cond.GE("$b", 123) },
-		"$c >= $1":                   func(cond *Cond) string { return cond.GTE("$c", 123) },
-		"$a < $1":                    func(cond *Cond) string { return cond.LessThan("$a", 123) },
-		"$b < $1":                    func(cond *Cond) string { return cond.L("$b", 123) },
-		"$c < $1":                    func(cond *Cond) string { return cond.LT("$c", 123) },
-		"$a <= $1":                   func(cond *Cond) string { return cond.LessEqualThan("$a", 123) },
-		"$b <= $1":                   func(cond *Cond) string { return cond.LE("$b", 123) },
-		"$c <= $1":                   func(cond *Cond) string { return cond.LTE("$c", 123) },
-		"$a IN ($1, $2, $3)":         func(cond *Cond) string { return cond.In("$a", 1, 2, 3) },
-		"$a NOT IN ($1, $2, $3)":     func(cond *Cond) string { return cond.NotIn("$a", 1, 2, 3) },
-		"$a LIKE $1":                 func(cond *Cond) string { return cond.Like("$a", "%Huan%") },
-		"$a ILIKE $1":                func(cond *Cond) string { return cond.ILike("$a", "%Huan%") },
-		"$a NOT LIKE $1":             func(cond *Cond) string { return cond.NotLike("$a", "%Huan%") },
-		"$a NOT ILIKE $1":            func(cond *Cond) string { return cond.NotILike("$a", "%Huan%") },
-		"$a IS NULL":                 func(cond *Cond) string { return cond.IsNull("$a") },
-		"$a IS NOT NULL":             func(cond *Cond) string { return cond.IsNotNull("$a") },
-		"$a BETWEEN $1 AND $2":       func(cond *Cond) string { return cond.Between("$a", 123, 456) },
-		"$a NOT BETWEEN $1 AND $2":   func(cond *Cond) string { return cond.NotBetween("$a", 123, 456) },
-		"NOT 1 = 1":                  func(cond *Cond) string { return cond.Not("1 = 1") },
-		"EXISTS ($1)":                func(cond *Cond) string { return cond.Exists(1) },
-		"NOT EXISTS ($1)":            func(cond *Cond) string { return cond.NotExists(1) },
-		"$a > ANY ($1, $2)":          func(cond *Cond) string { return cond.Any("$a", ">", 1, 2) },
-		"$a < ALL ($1)":              func(cond *Cond) string { return cond.All("$a", "<", 1) },
-		"$a > SOME ($1, $2, $3)":     func(cond *Cond) string { return cond.Some("$a", ">", 1, 2, 3) },
-		"$a IS DISTINCT FROM $1":     func(cond *Cond) string { return cond.IsDistinctFrom("$a", 1) },
-		"$a IS NOT DISTINCT FROM $1": func(cond *Cond) string { return cond.IsNotDistinctFrom("$a", 1) },
-		"$1":                         func(cond *Cond) string { return cond.Var(123) },
+		"$a = $1":                             func(cond *Cond) string { return cond.Equal("$a", 123) },
+		"$b = $1":                             func(cond *Cond) string { return cond.E("$b", 123) },
+		"$c = $1":                             func(cond *Cond) string { return cond.EQ("$c", 123) },
+		"$a <> $1":                            func(cond *Cond) string { return cond.NotEqual("$a", 123) },
+		"$b <> $1":                            func(cond *Cond) string { return cond.NE("$b", 123) },
+		"$c <> $1":                            func(cond *Cond) string { return cond.NEQ("$c", 123) },
+		"$a > $1":                             func(cond *Cond) string { return cond.GreaterThan("$a", 123) },
+		"$b > $1":                             func(cond *Cond) string { return cond.G("$b", 123) },
+		"$c > $1":                             func(cond *Cond) string { return cond.GT("$c", 123) },
+		"$a >= $1":                            func(cond *Cond) string { return cond.GreaterEqualThan("$a", 123) },
+		"$b >= $1":                            func(cond *Cond) string { return cond.GE("$b", 123) },
+		"$c >= $1":                            func(cond *Cond) string { return cond.GTE("$c", 123) },
+		"$a < $1":                             func(cond *Cond) string { return cond.LessThan("$a", 123) },
+		"$b < $1":                             func(cond *Cond) string { return cond.L("$b", 123) },
+		"$c < $1":                             func(cond *Cond) string { return cond.LT("$c", 123) },
+		"$a <= $1":                            func(cond *Cond) string { return cond.LessEqualThan("$a", 123) },
+		"$b <= $1":                            func(cond *Cond) string { return cond.LE("$b", 123) },
+		"$c <= $1":                            func(cond *Cond) string { return cond.LTE("$c", 123) },
+		"$a IN ($1, $2, $3)":                  func(cond *Cond) string { return cond.In("$a", 1, 2, 3) },
+		"$a NOT IN ($1, $2, $3)":              func(cond *Cond) string { return cond.NotIn("$a", 1, 2, 3) },
+		"$a LIKE $1":                          func(cond *Cond) string { return cond.Like("$a", "%Huan%") },
+		"$a ILIKE $1":                         func(cond *Cond) string { return cond.ILike("$a", "%Huan%") },
+		"$a LIKE $1 ESCAPE '\\'":              func(cond *Cond) string { return cond.LikeContains("$a", "Huan") },
+		"$b LIKE $1 ESCAPE '\\'":              func(cond *Cond) string { return cond.LikePrefix("$b", "Huan") },
+		"$c LIKE $1 ESCAPE '\\'":              func(cond *Cond) string { return cond.LikeSuffix("$c", "Huan") },
+		"$a NOT LIKE $1":                      func(cond *Cond) string { return cond.NotLike("$a", "%Huan%") },
+		"$a NOT ILIKE $1":                     func(cond *Cond) string { return cond.NotILike("$a", "%Huan%") },
+		"$a IS NULL":                          func(cond *Cond) string { return cond.IsNull("$a") },
+		"$a IS NOT NULL":                      func(cond *Cond) string { return cond.IsNotNull("$a") },
+		"($a IS NULL AND $b IS NULL)":         func(cond *Cond) string { return cond.AllNull("$a", "", "$b") },
+		"($a IS NULL OR $b IS NULL)":          func(cond *Cond) string { return cond.AnyNull("$a", "$b") },
+		"($a IS NOT NULL AND $b IS NOT NULL)": func(cond *Cond) string { return cond.AllNotNull("$a", "$b") },
+		"($a IS NOT NULL OR $b IS NOT NULL)":  func(cond *Cond) string { return cond.AnyNotNull("$a", "$b") },
+		"$a BETWEEN $1 AND $2":                func(cond *Cond) string { return cond.Between("$a", 123, 456) },
+		"$a NOT BETWEEN $1 AND $2":            func(cond *Cond) string { return cond.NotBetween("$a", 123, 456) },
+		"$a BETWEEN $1 AND NOW()":             func(cond *Cond) string { return cond.Between("$a", 123, Raw("NOW()")) },
+		"$a NOT BETWEEN NOW() AND $1":         func(cond *Cond) string { return cond.NotBetween("$a", Raw("NOW()"), 456) },
+		"NOT 1 = 1":                           func(cond *Cond) string { return cond.Not("1 = 1") },
+		"NOT (a = 1 OR b = 2)":                func(cond *Cond) string { return cond.Not("a = 1 OR b = 2") },
+		"NOT (a = 1 and b = 2)":               func(cond *Cond) string { return cond.Not("a = 1 and b = 2") },
+		"NOT ($a = $1 OR $b = $2)":            func(cond *Cond) string { return cond.Not(cond.Or(cond.E("$a", 1), cond.E("$b", 2))) },
+		"NOT name = 'x or y'":                 func(cond *Cond) string { return cond.Not("name = 'x or y'") },
+		"NOT COALESCE(a OR b, c)":             func(cond *Cond) string { return cond.Not("COALESCE(a OR b, c)") },
+		"NOT android = 1":                     func(cond *Cond) string { return cond.Not("android = 1") },
+		"EXISTS ($1)":                         func(cond *Cond) string { return cond.Exists(1) },
+		"NOT EXISTS ($1)":                     func(cond *Cond) string { return cond.NotExists(1) },
+		"$a > ANY ($1, $2)":                   func(cond *Cond) string { return cond.Any("$a", ">", 1, 2) },
+		"$a < ALL ($1)":                       func(cond *Cond) string { return cond.All("$a", "<", 1) },
+		"$a > SOME ($1, $2, $3)":              func(cond *Cond) string { return cond.Some("$a", ">", 1, 2, 3) },
+		"$a IS DISTINCT FROM $1":              func(cond *Cond) string { return cond.IsDistinctFrom("$a", 1) },
+		"$a IS NOT DISTINCT FROM $1":          func(cond *Cond) string { return cond.IsNotDistinctFrom("$a", 1) },
+		"$1":                                  func(cond *Cond) string { return cond.Var(123) },
 	}
 
 	for expected, f := range cases {
@@ -118,16 +134,28 @@ func TestEmptyCond(t *testing.T) {
 	cases := []func(cond *Cond) string{
 		func(cond *Cond) string { return cond.Equal("", 123) },
 		func(cond *Cond) string { return cond.NotEqual("", 123) },
+		func(cond *Cond) string { return cond.EqualCollate("", 123, "C") },
 		func(cond *Cond) string { return cond.GreaterThan("", 123) },
 		func(cond *Cond) string { return cond.GreaterEqualThan("", 123) },
 		func(cond *Cond) string { return cond.LessThan("", 123) },
 		func(cond *Cond) string { return cond.LessEqualThan("", 123) },
 		func(cond *Cond) string { return cond.In("", 1, 2, 3) },
 		func(cond *Cond) string { return cond.NotIn("", 1, 2, 3) },
+		func(cond *Cond) string { return cond.AnyLike(nil, "%Huan%") },
+		func(cond *Cond) string { return cond.AllNull() },
+		func(cond *Cond) string { return cond.AnyNull("", "") },
+		func(cond *Cond) string { return cond.AllNotNull() },
+		func(cond *Cond) string { return cond.AnyNotNull("") },
+		func(cond *Cond) string { return cond.AnyLike([]string{"", ""}, "%Huan%") },
+		func(cond *Cond) string { return cond.InFunc("", func() (interface{}, bool) { return 1, false }) },
+		func(cond *Cond) string { return cond.InFunc("a", nil) },
 		func(cond *Cond) string { return cond.Like("", "%Huan%") },
 		func(cond *Cond) string { return cond.ILike("", "%Huan%") },
 		func(cond *Cond) string { return cond.NotLike("", "%Huan%") },
 		func(cond *Cond) string { return cond.NotILike("", "%Huan%") },
+		func(cond *Cond) string { return cond.LikeContains("", "Huan") },
+		func(cond *Cond) string { return cond.LikePrefix("", "Huan") },
+		func(cond *Cond) string { return cond.LikeSuffix("", "Huan") },
 		func(cond *Cond) string { return cond.IsNull("") },
 		func(cond *Cond) string { return cond.IsNotNull("") },
 		func(cond *Cond) string { return cond.Between("", 123, 456) },
@@ -243,3 +271,526 @@ func TestCondMisuse(t *testing.T) {
 	a.Equal(sql, "SELECT * FROM t1 WHERE /* INVALID ARG $256 */")
 	a.Equal(args, nil)
 }
+
+func TestCondLikeEscaped(t *testing.T) {
+	a := assert.New(t)
+	cond := &Cond{
+		Args: &Args{},
+	}
+	format := strings.Join([]string{
+		cond.LikeContains("f1", "50%_off"),
+		cond.LikePrefix("f2", `a\b`),
+		cond.LikeSuffix("f3", "c"),
+	}, "\n")
+
+	sql, args := cond.Args.CompileWithFlavor(format, MySQL)
+	a.Equal(sql, `f1 LIKE ? ESCAPE '\\'
+f2 LIKE ? ESCAPE '\\'
+f3 LIKE ? ESCAPE '\\'`)
+	a.Equal(args, []interface{}{`%50\%\_off%`, `a\\b%`, "%c"})
+
+	sql, _ = cond.Args.CompileWithFlavor(format, PostgreSQL)
+	a.Equal(sql, `f1 LIKE $1 ESCAPE '\'
+f2 LIKE $2 ESCAPE '\'
+f3 LIKE $3 ESCAPE '\'`)
+
+	sql, _ = cond.Args.CompileWithFlavor(format, ClickHouse)
+	a.Equal(sql, `f1 LIKE ?
+f2 LIKE ?
+f3 LIKE ?`)
+}
+
+func TestCondInFunc(t *testing.T) {
+	a := assert.New(t)
+	cond := &Cond{
+		Args: &Args{},
+	}
+	values := []interface{}{1, "two", 3.0}
+	i := 0
+	next := func() (interface{}, bool) {
+		if i >= len(values) {
+			return nil, false
+		}
+
+		i++
+		return values[i-1], true
+	}
+
+	format := strings.Join([]string{
+		cond.InFunc("a", next),
+		cond.InFunc("b", next),
+	}, " AND ")
+
+	sql, args := cond.Args.CompileWithFlavor(format, PostgreSQL)
+	a.Equal(sql, "a IN ($1, $2, $3) AND 0 = 1")
+	a.Equal(args, values)
+
+	// A field expression works in InFunc like in other Cond methods.
+	i = 0
+	sql, args = cond.Args.CompileWithFlavor(cond.InFunc(cond.JSONExtract("data", "id"), next), MySQL)
+	a.Equal(sql, "data->>'$.id' IN (?, ?, ?)")
+	a.Equal(args, values)
+}
+
+func TestCondClone(t *testing.T) {
+	a := assert.New(t)
+	base := NewCond()
+	baseExpr := base.Equal("a", 1)
+
+	c1 := base.Clone()
+	c2 := base.Clone()
+	expr1 := c1.Equal("b", 2)
+	expr2 := c2.In("c", 3, 4)
+
+	sql, args := base.Args.Compile(baseExpr)
+	a.Equal(sql, "a = ?")
+	a.Equal(args, []interface{}{1})
+
+	sql, args = c1.Args.Compile(baseExpr + " AND " + expr1)
+	a.Equal(sql, "a = ? AND b = ?")
+	a.Equal(args, []interface{}{1, 2})
+
+	sql, args = c2.Args.CompileWithFlavor(baseExpr+" AND "+expr2, PostgreSQL)
+	a.Equal(sql, "a = $1 AND c IN ($2, $3)")
+	a.Equal(args, []interface{}{1, 3, 4})
+
+	a.Assert((&Cond{}).Clone().Args == nil)
+}
+
+func TestCondEqualCollate(t *testing.T) {
+	a := assert.New(t)
+	cond := NewCond()
+	format := cond.EqualCollate("name", "huan", "NOCASE")
+
+	sql, args := cond.Args.CompileWithFlavor(format, SQLite)
+	a.Equal(sql, "name = ? COLLATE NOCASE")
+	a.Equal(args, []interface{}{"huan"})
+
+	sql, _ = cond.Args.CompileWithFlavor(format, PostgreSQL)
+	a.Equal(sql, `name = $1 COLLATE "NOCASE"`)
+
+	sql, _ = cond.Args.CompileWithFlavor(cond.EqualCollate("name", "huan", ""), MySQL)
+	a.Equal(sql, "name = ?")
+}
+
+func TestCondAnyLike(t *testing.T) {
+	a := assert.New(t)
+	cond := NewCond()
+	format := cond.AnyLike([]string{"name", "", "email", "bio"}, "%huan%")
+
+	sql, args := cond.Args.CompileWithFlavor(format, PostgreSQL)
+	a.Equal(sql, "(name LIKE $1 OR email LIKE $2 OR bio LIKE $3)")
+	a.Equal(args, []interface{}{"%huan%", "%huan%", "%huan%"})
+}
+
+func TestCondInArray(t *testing.T) {
+	a := assert.New(t)
+	ids := []int{1, 2, 3}
+
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("*").From("user").Where(sb.InArray("id", ids))
+	sql, args := sb.Build()
+	a.Equal(sql, "SELECT * FROM user WHERE id = ANY($1)")
+	a.Equal(args, []interface{}{ids})
+
+	sb.SetFlavor(MySQL)
+	sql, args = sb.Build()
+	a.Equal(sql, "SELECT * FROM user WHERE id IN (?, ?, ?)")
+	a.Equal(args, []interface{}{1, 2, 3})
+
+	sb = MySQL.NewSelectBuilder()
+	sb.Select("*").From("user").Where(sb.InArray("id", []int{}))
+	sql, args = sb.Build()
+	a.Equal(sql, "SELECT * FROM user WHERE 0 = 1")
+	a.Equal(len(args), 0)
+
+	a.Equal(NewCond().InArray("", ids), "")
+}
+
+func TestCondLikeWithEscape(t *testing.T) {
+	a := assert.New(t)
+	cond := NewCond()
+	a.Equal(cond.LikeWithEscape("", "x", '!'), "")
+	a.Equal(cond.NotLikeWithEscape("", "x", '!'), "")
+
+	cases := []struct {
+		flavor   Flavor
+		expr     func(cond *Cond) string
+		expected string
+	}{
+		{PostgreSQL, func(cond *Cond) string { return cond.LikeWithEscape("name", "100!%", '!') }, "name LIKE $1 ESCAPE '!'"},
+		{MySQL, func(cond *Cond) string { return cond.LikeWithEscape("name", `100\%`, '\\') }, `name LIKE ? ESCAPE '\\'`},
+		{SQLite, func(cond *Cond) string { return cond.NotLikeWithEscape("name", `100\%`, '\\') }, `name NOT LIKE ? ESCAPE '\'`},
+		{SQLServer, func(cond *Cond) string { return cond.LikeWithEscape("name", "100'%", '\'') }, "name LIKE @p1 ESCAPE ''''"},
+		{CQL, func(cond *Cond) string { return cond.LikeWithEscape("name", "100!%", '!') }, "name LIKE ?"},
+	}
+
+	for _, c := range cases {
+		cond := NewCond()
+		sql, _ := cond.Args.CompileWithFlavor(c.expr(cond), c.flavor)
+		a.Equal(sql, c.expected)
+	}
+}
+
+func ExampleCond_Expr() {
+	sb := NewSelectBuilder()
+	sb.Select("*").From("user")
+	sb.Where(
+		sb.Like("name", sb.Expr("CONCAT('%', ?, '%')", "Huan")),
+		sb.Between("created_at", sb.Expr("NOW() - INTERVAL ? DAY", 7), sb.Expr("NOW()")),
+	)
+
+	fmt.Println(sb.BuildWithFlavor(PostgreSQL))
+
+	// Output:
+	// SELECT * FROM user WHERE name LIKE CONCAT('%', $1, '%') AND created_at BETWEEN NOW() - INTERVAL $2 DAY AND NOW() [Huan 7]
+}
+
+func TestCondExprOperand(t *testing.T) {
+	a := assert.New(t)
+	ub := NewUpdateBuilder()
+	ub.Update("user")
+	ub.Set(ub.Assign("name", ub.Expr("UPPER(?)", "huan")))
+	ub.Where(ub.In("id", ub.Expr("?", 1), ub.Expr("? + ?", 2, 3)), ub.Equal("note", ub.Expr("'?' || ?", "x")))
+	sql, args := ub.Build()
+	a.Equal(sql, "UPDATE user SET name = UPPER(?) WHERE id IN (?, ? + ?) AND note = '?' || ?")
+	a.Equal(args, []interface{}{"huan", 1, 2, 3, "x"})
+}
+
+func ExampleCond_InQuery() {
+	sub := Select("user_id").From("orders")
+	sub.Where(sub.GreaterThan("amount", 100))
+
+	sb := NewSelectBuilder()
+	sb.Select("*").From("users")
+	sb.Where(sb.InQuery("id", sub), sb.NotInQuery("id", Select("user_id").From("banned_users")))
+
+	fmt.Println(sb.BuildWithFlavor(PostgreSQL))
+
+	// Output:
+	// SELECT * FROM users WHERE id IN (SELECT user_id FROM orders WHERE amount > $1) AND id NOT IN (SELECT user_id FROM banned_users) [100]
+}
+
+func TestCondInQueryEmpty(t *testing.T) {
+	a := assert.New(t)
+	cond := NewCond()
+	a.Equal(cond.InQuery("", Select("1")), "")
+	a.Equal(cond.NotInQuery("id", nil), "")
+}
+
+func ExampleCond_Match() {
+	sb := MySQL.NewSelectBuilder()
+	sb.Select("*").From("articles")
+	sb.Where(sb.Match([]string{"title", "body"}, "+mysql -oracle", Boolean))
+
+	fmt.Println(sb.Build())
+
+	// Output:
+	// SELECT * FROM articles WHERE MATCH (title, body) AGAINST (? IN BOOLEAN MODE) [+mysql -oracle]
+}
+
+func TestCondMatch(t *testing.T) {
+	a := assert.New(t)
+	cond := NewCond()
+	a.Equal(cond.Match(nil, "x", Boolean), "")
+	a.Equal(cond.Match([]string{""}, "x", Boolean), "")
+
+	sql, _ := cond.Args.Compile(cond.Match([]string{"title"}, "x", ""))
+	a.Equal(sql, "MATCH (title) AGAINST (?)")
+
+	sql, _ = cond.Args.Compile(cond.Match([]string{"title", ""}, "x", WithQueryExpansion))
+	a.Equal(sql, "MATCH (title) AGAINST (? WITH QUERY EXPANSION)")
+}
+
+func TestCondRegexpMatch(t *testing.T) {
+	a := assert.New(t)
+	cases := map[Flavor][]string{
+		PostgreSQL: {"name ~ $1", "name ~* $1", "name !~ $1"},
+		MySQL:      {"name REGEXP ?", "REGEXP_LIKE(name, ?, 'i')", "name NOT REGEXP ?"},
+		SQLite:     {"name REGEXP ?", "name REGEXP '(?i)' || ?", "name NOT REGEXP ?"},
+		ClickHouse: {"match(name, ?)", "match(name, concat('(?i)', ?))", "NOT match(name, ?)"},
+		Presto:     {"regexp_like(name, ?)", "regexp_like(name, concat('(?i)', ?))", "NOT regexp_like(name, ?)"},
+		Oracle:     {"REGEXP_LIKE(name, :1)", "REGEXP_LIKE(name, :1, 'i')", "NOT REGEXP_LIKE(name, :1)"},
+	}
+
+	for flavor, expected := range cases {
+		cond := NewCond()
+		exprs := []string{
+			cond.RegexpMatch("name", "^a"),
+			cond.RegexpIMatch("name", "^a"),
+			cond.NotRegexpMatch("name", "^a"),
+		}
+
+		for i, expr := range exprs {
+			sql, args := cond.Args.CompileWithFlavor(expr, flavor)
+			a.Equal(sql, expected[i])
+			a.Equal(args, []interface{}{"^a"})
+		}
+	}
+
+	a.Equal(NewCond().RegexpMatch("", "^a"), "")
+}
+
+func ExampleCond_JSONExtract() {
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("id").From("users")
+	sb.Where(
+		sb.Equal(sb.JSONExtract("data", "address.city"), "Beijing"),
+		sb.JSONContains("data", `{"vip":true}`),
+		sb.JSONHasKey("data", "email"),
+	)
+
+	fmt.Println(sb.Build())
+
+	// Output:
+	// SELECT id FROM users WHERE data#>>'{address,city}' = $1 AND data @> $2 AND data ? 'email' [Beijing {"vip":true}]
+}
+
+func TestCondJSON(t *testing.T) {
+	a := assert.New(t)
+	sb := MySQL.NewSelectBuilder()
+	sb.Select("id").From("users")
+	sb.Where(
+		sb.Equal(sb.JSONExtract("data", "name"), "it's"),
+		sb.JSONContains("data", `{"vip":true}`),
+		sb.JSONHasKey("data", "o'k"),
+	)
+
+	sql, _ := sb.Build()
+	a.Equal(sql, "SELECT id FROM users WHERE data->>'$.name' = ? AND JSON_CONTAINS(data, ?) AND JSON_CONTAINS_PATH(data, 'one', '$.o''k')")
+
+	sb.SetFlavor(SQLite)
+	sql, _ = sb.Build()
+	a.Equal(sql, "SELECT id FROM users WHERE data->>'$.name' = ? AND JSON_CONTAINS(data, ?) AND json_type(data, '$.o''k') IS NOT NULL")
+
+	sb.SetFlavor(MariaDB)
+	sql, _ = sb.Build()
+	a.Equal(sql, "SELECT id FROM users WHERE JSON_UNQUOTE(JSON_EXTRACT(data, '$.name')) = ? AND JSON_CONTAINS(data, ?) AND JSON_CONTAINS_PATH(data, 'one', '$.o''k')")
+
+	cond := NewCond()
+	a.Equal(cond.JSONExtract("", "name"), "")
+	a.Equal(cond.JSONContains("", 1), "")
+	a.Equal(cond.JSONHasKey("", "k"), "")
+
+	cases := map[Flavor]string{
+		PostgreSQL: "data#>>'{a,b}'",
+		MySQL:      "data->>'$.a.b'",
+		SQLServer:  "JSON_VALUE(data, '$.a.b')",
+		ClickHouse: "JSONExtractString(data, 'a', 'b')",
+		Presto:     "json_extract_scalar(data, '$.a.b')",
+	}
+	expr := cond.JSONExtract("data", "a.b")
+
+	for flavor, expected := range cases {
+		sql, _ := cond.Args.CompileWithFlavor(expr, flavor)
+		a.Equal(sql, expected)
+	}
+
+	sql, _ = cond.Args.CompileWithFlavor(cond.JSONExtract("data", "name"), PostgreSQL)
+	a.Equal(sql, "data->>'name'")
+}
+
+func TestCondFieldWithDollar(t *testing.T) {
+	a := assert.New(t)
+	sb := MySQL.NewSelectBuilder()
+	sb.Select("id").From("t")
+	sb.Where(sb.Equal("a$2", 1), sb.Equal("b$$c", 2), sb.Compare("$0", "=", 3))
+
+	sql, args := sb.Build()
+	a.Equal(sql, "SELECT id FROM t WHERE a$2 = ? AND b$$c = ? AND $0 = ?")
+	a.Equal(args, []interface{}{1, 2, 3})
+}
+
+func ExampleCond_Compare() {
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("*").From("posts")
+	sb.Where(
+		sb.Compare(sb.Value("go"), "=", sb.Col("ANY(tags)")),
+		sb.Compare("updated_at", ">", sb.Col("created_at")),
+		sb.Compare("score", ">=", 60),
+	)
+
+	fmt.Println(sb.Build())
+
+	// Output:
+	// SELECT * FROM posts WHERE $1 = ANY(tags) AND updated_at > created_at AND score >= $2 [go 60]
+}
+
+func TestCondCompare(t *testing.T) {
+	a := assert.New(t)
+	cond := NewCond()
+	a.Equal(cond.Compare("", "=", 1), "")
+	a.Equal(cond.Compare("a", "", 1), "")
+
+	sql, args := cond.Args.Compile(cond.Equal("a", cond.Col("b")))
+	a.Equal(sql, "a = b")
+	a.Equal(len(args), 0)
+
+	sql, args = cond.Args.Compile(cond.Compare(1, "<", cond.Value(2)))
+	a.Equal(sql, "? < ?")
+	a.Equal(args, []interface{}{1, 2})
+}
+
+func ExampleCond_ExistsQuery() {
+	sub := Select("1").From("orders o")
+	sub.Where(
+		"o.user_id = u.id",
+		sub.GreaterThan("o.amount", 100),
+	)
+
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("u.id").From("users u")
+	sb.Where(
+		sb.Equal("u.status", 1),
+		sb.ExistsQuery(sub),
+	)
+
+	sql, args := sb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT u.id FROM users u WHERE u.status = $1 AND EXISTS (SELECT 1 FROM orders o WHERE o.user_id = u.id AND o.amount > $2)
+	// [1 100]
+}
+
+func TestCondExistsQuery(t *testing.T) {
+	a := assert.New(t)
+	cond := NewCond()
+	a.Equal(cond.ExistsQuery(nil), "")
+	a.Equal(cond.NotExistsQuery(nil), "")
+
+	sql, args := cond.Args.Compile(cond.NotExistsQuery(Select("1").From("t2").Where("t2.id = t1.id")))
+	a.Equal(sql, "NOT EXISTS (SELECT 1 FROM t2 WHERE t2.id = t1.id)")
+	a.Equal(len(args), 0)
+}
+
+func ExampleCond_BetweenCols() {
+	sb := Select("*").From("bookings b")
+	sb.Where(
+		sb.BetweenCols("b.start_at", "b.window_start", "b.window_end"),
+		sb.NotBetweenCols("b.end_at", "b.blackout_start", "b.blackout_end"),
+		sb.Between("b.price", 10, sb.Col("b.max_price")),
+	)
+
+	sql, args := sb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT * FROM bookings b WHERE b.start_at BETWEEN b.window_start AND b.window_end AND b.end_at NOT BETWEEN b.blackout_start AND b.blackout_end AND b.price BETWEEN ? AND b.max_price
+	// [10]
+}
+
+func TestCondBetweenCols(t *testing.T) {
+	a := assert.New(t)
+	cond := NewCond()
+	a.Equal(cond.BetweenCols("", "a", "b"), "")
+	a.Equal(cond.BetweenCols("f", "", "b"), "")
+	a.Equal(cond.NotBetweenCols("f", "a", ""), "")
+}
+
+func ExampleCond_TupleInQuery() {
+	sub := Select("user_id", "org_id").From("memberships")
+	sub.Where(sub.Equal("role", "admin"))
+
+	sb := Select("*").From("events")
+	sb.Where(
+		sb.TupleInQuery([]string{"user_id", "org_id"}, sub),
+		sb.GreaterThan("created_at", 1234567890),
+	)
+
+	sql, args := sb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT * FROM events WHERE (user_id, org_id) IN (SELECT user_id, org_id FROM memberships WHERE role = ?) AND created_at > ?
+	// [admin 1234567890]
+}
+
+func TestCondTupleInQuery(t *testing.T) {
+	a := assert.New(t)
+	cond := NewCond()
+	a.Equal(cond.TupleInQuery(nil, Select("1")), "")
+	a.Equal(cond.TupleInQuery([]string{"a"}, nil), "")
+}
+
+func ExampleCond_Coalesce() {
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select(
+		"id",
+		sb.As(sb.Coalesce("nickname", "name", sb.Value("anonymous")), "display_name"),
+		sb.As(sb.Greatest("score", 0), "score"),
+	)
+	sb.From("users")
+	sb.Where(sb.NullIf("email", sb.Value("")) + " IS NOT NULL")
+
+	sql, args := sb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT id, COALESCE(nickname, name, $1) AS display_name, GREATEST(score, $2) AS score FROM users WHERE NULLIF(email, $3) IS NOT NULL
+	// [anonymous 0 ]
+}
+
+func TestCondFuncCall(t *testing.T) {
+	a := assert.New(t)
+	cond := NewCond()
+	a.Equal(cond.Coalesce(), "")
+
+	expr := cond.Least("a", cond.Col("b + 1"), Raw("NOW()"), 3)
+	sql, args := cond.Args.CompileWithFlavor(expr, MySQL)
+	a.Equal(sql, "LEAST(a, b + 1, NOW(), ?)")
+	a.Equal(args, []interface{}{3})
+
+	sql, _ = cond.Args.CompileWithFlavor(expr, SQLite)
+	a.Equal(sql, "MIN(a, b + 1, NOW(), ?)")
+
+	sql, _ = cond.Args.CompileWithFlavor(cond.Greatest("a", "b"), SQLite)
+	a.Equal(sql, "MAX(a, b)")
+}
+
+func ExampleCond_AnyQuery() {
+	sub := Select("price").From("products")
+	sub.Where(sub.Equal("category", "book"))
+
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("*").From("orders")
+	sb.Where(
+		sb.GreaterThan("created_at", "2024-01-01"),
+		sb.AnyQuery("amount", ">", sub),
+	)
+
+	sql, args := sb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT * FROM orders WHERE created_at > $1 AND amount > ANY (SELECT price FROM products WHERE category = $2)
+	// [2024-01-01 book]
+}
+
+func TestCondQuantifiedQuery(t *testing.T) {
+	a := assert.New(t)
+	sub := Select("score").From("t")
+	sub.Where(sub.GreaterThan("score", 10), sub.LessThan("score", 20))
+
+	cond := NewCond()
+	a.Equal(cond.AnyQuery("", ">", sub), "")
+	a.Equal(cond.AllQuery("a", "", sub), "")
+	a.Equal(cond.SomeQuery("a", "=", nil), "")
+
+	expr := cond.And(
+		cond.Equal("x", 1),
+		cond.AllQuery("a", ">=", sub),
+		cond.SomeQuery("b", "<>", sub),
+	)
+	sql, args := cond.Args.CompileWithFlavor(expr, PostgreSQL)
+	a.Equal(sql, "(x = $1 AND a >= ALL (SELECT score FROM t WHERE score > $2 AND score < $3) AND b <> SOME (SELECT score FROM t WHERE score > $4 AND score < $5))")
+	a.Equal(args, []interface{}{1, 10, 20, 10, 20})
+
+	sql, _ = cond.Args.CompileWithFlavor(expr, MySQL)
+	a.Equal(sql, "(x = ? AND a >= ALL (SELECT score FROM t WHERE score > ? AND score < ?) AND b <> SOME (SELECT score FROM t WHERE score > ? AND score < ?))")
+}
@@ -0,0 +1,195 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+const (
+	createIndexMarkerInit injectionMarker = iota
+	createIndexMarkerAfterCreate
+	createIndexMarkerAfterOn
+	createIndexMarkerAfterWhere
+)
+
+// NewCreateIndexBuilder creates a new CREATE INDEX builder.
+func NewCreateIndexBuilder() *CreateIndexBuilder {
+	return DefaultFlavor.NewCreateIndexBuilder()
+}
+
+func newCreateIndexBuilder() *CreateIndexBuilder {
+	return &CreateIndexBuilder{
+		args:      &Args{},
+		injection: newInjection(),
+		marker:    createIndexMarkerInit,
+	}
+}
+
+// CreateIndexBuilder is a builder to build CREATE INDEX.
+type CreateIndexBuilder struct {
+	unique      bool
+	ifNotExists bool
+	name        string
+	table       string
+	cols        []string
+	using       string
+	whereExprs  []string
+
+	args *Args
+
+	injection *injection
+	marker    injectionMarker
+}
+
+var _ Builder = new(CreateIndexBuilder)
+
+// CreateIndex sets the index name in CREATE INDEX.
+func CreateIndex(name string) *CreateIndexBuilder {
+	return DefaultFlavor.NewCreateIndexBuilder().CreateIndex(name)
+}
+
+// CreateIndex sets the index name in CREATE INDEX.
+func (cib *CreateIndexBuilder) CreateIndex(name string) *CreateIndexBuilder {
+	cib.name = Escape(name)
+	cib.marker = createIndexMarkerAfterCreate
+	return cib
+}
+
+// Unique marks the index as a unique index.
+func (cib *CreateIndexBuilder) Unique() *CreateIndexBuilder {
+	cib.unique = true
+	return cib
+}
+
+// IfNotExists adds IF NOT EXISTS before index name in CREATE INDEX.
+// It's written in PostgreSQL, SQLite, MariaDB and DuckDB and omitted in other flavors.
+func (cib *CreateIndexBuilder) IfNotExists() *CreateIndexBuilder {
+	cib.ifNotExists = true
+	return cib
+}
+
+// On sets the table and the indexed columns.
+// A col can be an expression or contain a direction, e.g. "LOWER(name)" or "created_at DESC".
+func (cib *CreateIndexBuilder) On(table string, col ...string) *CreateIndexBuilder {
+	cib.table = Escape(table)
+	cib.cols = EscapeAll(col...)
+	cib.marker = createIndexMarkerAfterOn
+	return cib
+}
+
+// Using sets the index method, e.g. "GIN" in PostgreSQL or "BTREE" in MySQL.
+//
+// It's written as "ON t USING method (cols)" in PostgreSQL and "ON t (cols) USING method" in MySQL and MariaDB.
+// It's omitted in other flavors.
+func (cib *CreateIndexBuilder) Using(method string) *CreateIndexBuilder {
+	cib.using = method
+	return cib
+}
+
+// Where adds conditions of a partial index. Expressions are joined by AND.
+// As most database systems don't accept bound args in CREATE INDEX, expressions are written as they are.
+//
+// It's written in PostgreSQL, SQLite and SQLServer (as a filtered index) and omitted in other flavors.
+func (cib *CreateIndexBuilder) Where(andExpr ...string) *CreateIndexBuilder {
+	if estimateStringsBytes(andExpr) == 0 {
+		return cib
+	}
+
+	for _, expr := range andExpr {
+		if expr != "" {
+			cib.whereExprs = append(cib.whereExprs, Escape(expr))
+		}
+	}
+
+	cib.marker = createIndexMarkerAfterWhere
+	return cib
+}
+
+// String returns the compiled CREATE INDEX string.
+func (cib *CreateIndexBuilder) String() string {
+	s, _ := cib.Build()
+	return s
+}
+
+// Build returns compiled CREATE INDEX string and args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (cib *CreateIndexBuilder) Build() (sql string, args []interface{}) {
+	return cib.BuildWithFlavor(cib.args.Flavor)
+}
+
+// BuildWithFlavor returns compiled CREATE INDEX string and args with flavor and initial args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (cib *CreateIndexBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	buf := newStringBuilder()
+	cib.injection.WriteTo(buf, createIndexMarkerInit)
+
+	if len(cib.name) > 0 {
+		buf.WriteLeadingString("CREATE")
+
+		if cib.unique {
+			buf.WriteString(" UNIQUE")
+		}
+
+		buf.WriteString(" INDEX")
+
+		if cib.ifNotExists {
+			switch flavor {
+			case PostgreSQL, SQLite, MariaDB, DuckDB:
+				buf.WriteString(" IF NOT EXISTS")
+			}
+		}
+
+		buf.WriteRune(' ')
+		buf.WriteString(cib.name)
+	}
+
+	cib.injection.WriteTo(buf, createIndexMarkerAfterCreate)
+
+	if len(cib.table) > 0 {
+		buf.WriteLeadingString("ON ")
+		buf.WriteString(cib.table)
+
+		if cib.using != "" && flavor == PostgreSQL {
+			buf.WriteString(" USING ")
+			buf.WriteString(cib.using)
+		}
+
+		buf.WriteString(" (")
+		buf.WriteStrings(cib.cols, ", ")
+		buf.WriteRune(')')
+
+		if cib.using != "" && flavor.isMySQLCompatible() {
+			buf.WriteString(" USING ")
+			buf.WriteString(cib.using)
+		}
+
+		cib.injection.WriteTo(buf, createIndexMarkerAfterOn)
+	}
+
+	if len(cib.whereExprs) > 0 {
+		switch flavor {
+		case PostgreSQL, SQLite, SQLServer:
+			buf.WriteLeadingString("WHERE ")
+			buf.WriteStrings(cib.whereExprs, " AND ")
+			cib.injection.WriteTo(buf, createIndexMarkerAfterWhere)
+		}
+	}
+
+	return cib.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+}
+
+// SetFlavor sets the flavor of compiled sql.
+func (cib *CreateIndexBuilder) SetFlavor(flavor Flavor) (old Flavor) {
+	old = cib.args.Flavor
+	cib.args.Flavor = flavor
+	return
+}
+
+// Flavor returns flavor of builder
+func (cib *CreateIndexBuilder) Flavor() Flavor {
+	return cib.args.Flavor
+}
+
+// SQL adds an arbitrary sql to current position.
+func (cib *CreateIndexBuilder) SQL(sql string) *CreateIndexBuilder {
+	cib.injection.SQL(cib.marker, sql)
+	return cib
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleCreateIndex() {
+	cib := PostgreSQL.NewCreateIndexBuilder()
+	cib.CreateIndex("idx_user_tags").On("demo.user", "tags").Using("GIN")
+	fmt.Println(cib)
+
+	cib = PostgreSQL.NewCreateIndexBuilder()
+	cib.CreateIndex("uniq_user_email").Unique().IfNotExists()
+	cib.On("demo.user", "LOWER(email)").Where("deleted_at IS NULL")
+	fmt.Println(cib)
+
+	// Output:
+	// CREATE INDEX idx_user_tags ON demo.user USING GIN (tags)
+	// CREATE UNIQUE INDEX IF NOT EXISTS uniq_user_email ON demo.user (LOWER(email)) WHERE deleted_at IS NULL
+}
+
+func ExampleCreateIndexBuilder_SQL() {
+	cib := NewCreateIndexBuilder()
+	cib.SQL("/* before */")
+	cib.CreateIndex("idx_name")
+	cib.SQL("/* after create */")
+	cib.On("demo.user", "name")
+	cib.SQL("/* after on */")
+
+	fmt.Println(cib)
+
+	// Output:
+	// /* before */ CREATE INDEX idx_name /* after create */ ON demo.user (name) /* after on */
+}
+
+func TestCreateIndexBuilderFlavors(t *testing.T) {
+	a := assert.New(t)
+	cib := CreateIndex("idx_a").On("t", "a", "b DESC").Using("BTREE").Where("a > 0", "", "b <> '$'")
+
+	cases := map[Flavor]string{
+		MySQL:      "CREATE INDEX idx_a ON t (a, b DESC) USING BTREE",
+		PostgreSQL: "CREATE INDEX idx_a ON t USING BTREE (a, b DESC) WHERE a > 0 AND b <> '$'",
+		SQLite:     "CREATE INDEX idx_a ON t (a, b DESC) WHERE a > 0 AND b <> '$'",
+		SQLServer:  "CREATE INDEX idx_a ON t (a, b DESC) WHERE a > 0 AND b <> '$'",
+		Oracle:     "CREATE INDEX idx_a ON t (a, b DESC)",
+	}
+
+	for flavor, expected := range cases {
+		sql, args := cib.BuildWithFlavor(flavor)
+		a.Equal(sql, expected)
+		a.Equal(len(args), 0)
+	}
+
+	cib = CreateIndex("idx_a").Unique().IfNotExists().On("t", "a")
+	sql, _ := cib.BuildWithFlavor(MariaDB)
+	a.Equal(sql, "CREATE UNIQUE INDEX IF NOT EXISTS idx_a ON t (a)")
+	sql, _ = cib.BuildWithFlavor(MySQL)
+	a.Equal(sql, "CREATE UNIQUE INDEX idx_a ON t (a)")
+	sql, _ = cib.BuildWithFlavor(SQLServer)
+	a.Equal(sql, "CREATE UNIQUE INDEX idx_a ON t (a)")
+
+	cibPg := PostgreSQL.NewCreateIndexBuilder()
+	a.Equal(PostgreSQL, cibPg.Flavor())
+	a.Equal(cibPg.Where("").String(), "")
+}
@@ -36,6 +36,8 @@ type CreateTableBuilder struct {
 	table       string
 	defs        [][]string
 	options     [][]string
+	comment     *string
+	colComments []columnComment
 
 	args *Args
 
@@ -43,6 +45,11 @@ type CreateTableBuilder struct {
 	marker    injectionMarker
 }
 
+type columnComment struct {
+	col  string
+	text string
+}
+
 var _ Builder = new(CreateTableBuilder)
 
 // CreateTable sets the table name in CREATE TABLE.
@@ -85,6 +92,98 @@ func (ctb *CreateTableBuilder) Option(opt ...string) *CreateTableBuilder {
 	return ctb
 }
 
+// Comment sets the comment of the table.
+//
+// It's written as a table option "COMMENT = 'text'" in MySQL and MariaDB.
+// PostgreSQL, Oracle and DuckDB set comments by separated COMMENT ON statements,
+// which are returned by `CommentStatements` instead.
+// It's omitted in other flavors.
+func (ctb *CreateTableBuilder) Comment(text string) *CreateTableBuilder {
+	ctb.comment = &text
+	return ctb
+}
+
+// ColumnComment sets the comment of the column col.
+// The col must be the same as the column name in `Define` or `Column`.
+//
+// It's written as "COMMENT 'text'" at the end of the column definition in MySQL and MariaDB.
+// PostgreSQL, Oracle and DuckDB set comments by separated COMMENT ON statements,
+// which are returned by `CommentStatements` instead.
+// It's omitted in other flavors.
+func (ctb *CreateTableBuilder) ColumnComment(col, text string) *CreateTableBuilder {
+	for i := range ctb.colComments {
+		if ctb.colComments[i].col == col {
+			ctb.colComments[i].text = text
+			return ctb
+		}
+	}
+
+	ctb.colComments = append(ctb.colComments, columnComment{
+		col:  col,
+		text: text,
+	})
+	return ctb
+}
+
+// CommentStatements returns COMMENT ON statements for the table and column comments
+// set by `Comment` and `ColumnComment`.
+// They should be executed after the CREATE TABLE statement.
+func (ctb *CreateTableBuilder) CommentStatements() []string {
+	return ctb.CommentStatementsWithFlavor(ctb.args.Flavor)
+}
+
+// CommentStatementsWithFlavor returns COMMENT ON statements with flavor.
+// It returns nil if flavor writes comments in CREATE TABLE or doesn't support comments.
+func (ctb *CreateTableBuilder) CommentStatementsWithFlavor(flavor Flavor) []string {
+	switch flavor {
+	case PostgreSQL, Oracle, DuckDB:
+	default:
+		return nil
+	}
+
+	if len(ctb.table) == 0 {
+		return nil
+	}
+
+	var stmts []string
+	args := &Args{}
+	add := func(target, text string) {
+		if literal, ok := literalWithFlavor(flavor, text); ok {
+			sql, _ := args.CompileWithFlavor("COMMENT ON "+target+" IS "+Escape(literal), flavor)
+			stmts = append(stmts, sql)
+		}
+	}
+
+	if ctb.comment != nil {
+		add("TABLE "+ctb.table, *ctb.comment)
+	}
+
+	for _, cc := range ctb.colComments {
+		add("COLUMN "+ctb.table+"."+Escape(cc.col), cc.text)
+	}
+
+	return stmts
+}
+
+// inlineColumnComment returns the COMMENT clause of col if flavor supports inline comments.
+func (ctb *CreateTableBuilder) inlineColumnComment(flavor Flavor, col string) string {
+	if !flavor.isMySQLCompatible() {
+		return ""
+	}
+
+	for _, cc := range ctb.colComments {
+		if cc.col != col {
+			continue
+		}
+
+		if literal, ok := literalWithFlavor(flavor, cc.text); ok {
+			return "COMMENT " + literal
+		}
+	}
+
+	return ""
+}
+
 // NumDefine returns the number of definitions in CREATE TABLE.
 func (ctb *CreateTableBuilder) NumDefine() int {
 	return len(ctb.defs)
@@ -128,7 +227,15 @@ func (ctb *CreateTableBuilder) BuildWithFlavor(flavor Flavor, initialArg ...inte
 		defs := make([]string, 0, len(ctb.defs))
 
 		for _, def := range ctb.defs {
-			defs = append(defs, strings.Join(def, " "))
+			d := strings.Join(def, " ")
+
+			if len(def) > 0 {
+				if comment := ctb.inlineColumnComment(flavor, def[0]); comment != "" {
+					d += " " + Escape(comment)
+				}
+			}
+
+			defs = append(defs, d)
 		}
 
 		buf.WriteStrings(defs, ", ")
@@ -137,13 +244,19 @@ func (ctb *CreateTableBuilder) BuildWithFlavor(flavor Flavor, initialArg ...inte
 		ctb.injection.WriteTo(buf, createTableMarkerAfterDefine)
 	}
 
-	if len(ctb.options) > 0 {
-		opts := make([]string, 0, len(ctb.options))
+	opts := make([]string, 0, len(ctb.options)+1)
 
-		for _, opt := range ctb.options {
-			opts = append(opts, strings.Join(opt, " "))
+	for _, opt := range ctb.options {
+		opts = append(opts, strings.Join(opt, " "))
+	}
+
+	if ctb.comment != nil && flavor.isMySQLCompatible() {
+		if literal, ok := literalWithFlavor(flavor, *ctb.comment); ok {
+			opts = append(opts, "COMMENT = "+Escape(literal))
 		}
+	}
 
+	if len(opts) > 0 {
 		buf.WriteLeadingString(strings.Join(opts, ", "))
 		ctb.injection.WriteTo(buf, createTableMarkerAfterOption)
 	}
@@ -102,3 +102,121 @@ func TestCreateTableGetFlavor(t *testing.T) {
 	flavor = ctbClick.Flavor()
 	a.Equal(ClickHouse, flavor)
 }
+
+func ExampleCreateTableBuilder_Column() {
+	ctb := NewCreateTableBuilder()
+	ctb.CreateTable("demo.user").IfNotExists()
+	ctb.Column("id").Type(TypeBigInt).NotNull().AutoIncrement().PrimaryKey()
+	ctb.Column("name").Type(TypeVarchar(255)).NotNull().Default("")
+	ctb.Column("enabled").Type(TypeBoolean).NotNull().Default(true)
+	ctb.Column("created_at").Type(TypeTimestamp).NotNull().Default(Raw("CURRENT_TIMESTAMP"))
+	ctb.Define("KEY", "idx_name", "(name)")
+
+	fmt.Println(ctb.BuildWithFlavor(MySQL))
+	fmt.Println(ctb.BuildWithFlavor(PostgreSQL))
+
+	// Output:
+	// CREATE TABLE IF NOT EXISTS demo.user (id BIGINT AUTO_INCREMENT NOT NULL PRIMARY KEY, name VARCHAR(255) NOT NULL DEFAULT '', enabled TINYINT(1) NOT NULL DEFAULT TRUE, created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP, KEY idx_name (name)) []
+	// CREATE TABLE IF NOT EXISTS demo.user (id BIGSERIAL NOT NULL PRIMARY KEY, name VARCHAR(255) NOT NULL DEFAULT E'', enabled BOOLEAN NOT NULL DEFAULT TRUE, created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP, KEY idx_name (name)) []
+}
+
+func TestColumnDef(t *testing.T) {
+	a := assert.New(t)
+	id := new(ColumnDef)
+	id.name = "id"
+	id.Type(TypeInt).AutoIncrement().PrimaryKey()
+
+	cases := map[Flavor]string{
+		SQLite:     "id INTEGER PRIMARY KEY AUTOINCREMENT",
+		SQLServer:  "id INT IDENTITY(1,1) PRIMARY KEY",
+		Oracle:     "id NUMBER(10) GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY",
+		Informix:   "id SERIAL PRIMARY KEY",
+		ClickHouse: "id Int32 PRIMARY KEY",
+	}
+
+	for flavor, expected := range cases {
+		a.Equal(id.SQL(flavor), expected)
+	}
+
+	price := new(ColumnDef)
+	price.name = "price"
+	price.Type(TypeDecimal(10, 2)).NotNull().Null().Unique().Default(1.5)
+	a.Equal(price.SQL(MySQL), "price DECIMAL(10, 2) NULL DEFAULT 1.5 UNIQUE")
+	a.Equal(price.SQL(Oracle), "price NUMBER(10, 2) NULL DEFAULT 1.5 UNIQUE")
+
+	types := map[Flavor][]string{
+		MySQL:      {"TINYINT(1)", "SMALLINT", "TEXT", "DOUBLE", "DATE", "BLOB", "JSON"},
+		PostgreSQL: {"BOOLEAN", "SMALLINT", "TEXT", "DOUBLE PRECISION", "DATE", "BYTEA", "JSON"},
+		SQLServer:  {"BIT", "SMALLINT", "NVARCHAR(MAX)", "FLOAT", "DATE", "VARBINARY(MAX)", "JSON"},
+		ClickHouse: {"Bool", "Int16", "String", "Float64", "Date", "String", "JSON"},
+	}
+
+	for flavor, expected := range types {
+		actual := []string{}
+
+		for _, typ := range []ColumnType{TypeBoolean, TypeSmallInt, TypeText, TypeDouble, TypeDate, TypeBlob, TypeRaw("JSON")} {
+			actual = append(actual, typ.SQL(flavor))
+		}
+
+		a.Equal(actual, expected)
+	}
+
+	ctb := PostgreSQL.NewCreateTableBuilder()
+	ctb.CreateTable("t")
+	ctb.Column("data").Type(TypeBlob).Default(struct{}{})
+	ctb.Column("v").Type(TypeDouble).AutoIncrement()
+	a.Equal(ctb.NumDefine(), 2)
+	a.Equal(ctb.String(), "CREATE TABLE t (data BYTEA, v DOUBLE PRECISION GENERATED BY DEFAULT AS IDENTITY)")
+}
+
+func ExampleCreateTableBuilder_Comment() {
+	ctb := NewCreateTableBuilder()
+	ctb.CreateTable("users")
+	ctb.Define("id", "BIGINT(20)", "NOT NULL", "PRIMARY KEY")
+	ctb.Column("name").Type(TypeVarchar(255)).NotNull()
+	ctb.Comment("All users")
+	ctb.ColumnComment("id", "User ID")
+	ctb.ColumnComment("name", "Name of the user")
+
+	ctb.SetFlavor(MySQL)
+	fmt.Println(ctb)
+	fmt.Println(ctb.CommentStatements())
+
+	ctb.SetFlavor(Oracle)
+	fmt.Println(ctb)
+
+	for _, stmt := range ctb.CommentStatements() {
+		fmt.Println(stmt)
+	}
+
+	// Output:
+	// CREATE TABLE users (id BIGINT(20) NOT NULL PRIMARY KEY COMMENT 'User ID', name VARCHAR(255) NOT NULL COMMENT 'Name of the user') COMMENT = 'All users'
+	// []
+	// CREATE TABLE users (id BIGINT(20) NOT NULL PRIMARY KEY, name VARCHAR2(255) NOT NULL)
+	// COMMENT ON TABLE users IS 'All users'
+	// COMMENT ON COLUMN users.id IS 'User ID'
+	// COMMENT ON COLUMN users.name IS 'Name of the user'
+}
+
+func TestCreateTableComment(t *testing.T) {
+	a := assert.New(t)
+	ctb := CreateTable("t$1")
+	ctb.Define("a$b", "INT")
+	ctb.Option("ENGINE=InnoDB")
+	ctb.Comment("cost $1")
+	ctb.ColumnComment("a$b", "old")
+	ctb.ColumnComment("a$b", "new $")
+
+	sql, args := ctb.BuildWithFlavor(MySQL)
+	a.Equal(sql, "CREATE TABLE t$1 (a$b INT COMMENT 'new $') ENGINE=InnoDB, COMMENT = 'cost $1'")
+	a.Equal(len(args), 0)
+	a.Equal(ctb.CommentStatementsWithFlavor(PostgreSQL), []string{
+		"COMMENT ON TABLE t$1 IS E'cost $1'",
+		"COMMENT ON COLUMN t$1.a$b IS E'new $'",
+	})
+	a.Equal(ctb.CommentStatementsWithFlavor(SQLite), []string(nil))
+
+	sql, _ = ctb.BuildWithFlavor(SQLServer)
+	a.Equal(sql, "CREATE TABLE t$1 (a$b INT) ENGINE=InnoDB")
+	a.Equal(NewCreateTableBuilder().Comment("x").CommentStatementsWithFlavor(PostgreSQL), []string(nil))
+}
@@ -77,6 +77,30 @@ func (cteb *CTEBuilder) Update(table string) *UpdateBuilder {
 	return ub.With(cteb).Update(table)
 }
 
+// Clone returns a deep copy of cteb.
+// All CTE queries in cteb are cloned as well, so changing them in the clone doesn't affect cteb.
+func (cteb *CTEBuilder) Clone() *CTEBuilder {
+	cloned := *cteb
+	cloned.args = cteb.args.clone()
+	cloned.injection = cteb.injection.clone()
+	cloned.queryBuilderVars = append([]string(nil), cteb.queryBuilderVars...)
+	cloned.queries = make([]*CTEQueryBuilder, 0, len(cteb.queries))
+
+	for _, query := range cteb.queries {
+		clonedQuery := query.Clone()
+
+		for i, arg := range cloned.args.argValues {
+			if arg == query {
+				cloned.args.argValues[i] = clonedQuery
+			}
+		}
+
+		cloned.queries = append(cloned.queries, clonedQuery)
+	}
+
+	return &cloned
+}
+
 // String returns the compiled CTE string.
 func (cteb *CTEBuilder) String() string {
 	sql, _ := cteb.Build()
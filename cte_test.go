@@ -193,3 +193,53 @@ func TestCTEQueryBuilderGetFlavor(t *testing.T) {
 	flavor = ctetbClick.Flavor()
 	a.Equal(ClickHouse, flavor)
 }
+
+func TestCTEQueryBuilderAsRaw(t *testing.T) {
+	a := assert.New(t)
+	sb := With(
+		CTETable("ids", "id").AsRaw("VALUES ($?), ($?)", 1, 2),
+	).Select("ids.id", "u.name")
+	sb.Join("users u", "u.id = ids.id").Where(sb.GreaterThan("u.level", 3))
+
+	sql, args := sb.BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "WITH ids (id) AS (VALUES ($1), ($2)) SELECT ids.id, u.name FROM ids JOIN users u ON u.id = ids.id WHERE u.level > $3")
+	a.Equal(args, []interface{}{1, 2, 3})
+}
+
+func TestCTEBuilderClone(t *testing.T) {
+	a := assert.New(t)
+	cteb := With(
+		CTETable("users", "id", "name").As(Select("id", "name").From("users").Where("name IS NOT NULL")),
+	)
+
+	cloned := cteb.Clone()
+	cloned.queries[0].Table("members", "id")
+	cloned.SQL("/* cloned */")
+
+	a.Equal(cteb.String(), "WITH users (id, name) AS (SELECT id, name FROM users WHERE name IS NOT NULL)")
+	a.Equal(cloned.String(), "WITH members (id) AS (SELECT id, name FROM users WHERE name IS NOT NULL) /* cloned */")
+
+	cloned = cteb.Clone()
+	cloned.With(CTEQuery("orders").As(Select("*").From("orders")))
+	a.Equal(cteb.String(), "WITH users (id, name) AS (SELECT id, name FROM users WHERE name IS NOT NULL)")
+	a.Equal(cloned.String(), "WITH orders AS (SELECT * FROM orders)")
+
+	ctetb := CTEQuery("t", "a").As(Select("1"))
+	clonedQuery := ctetb.Clone().Table("t2")
+	a.Equal(ctetb.String(), "t (a) AS (SELECT 1)")
+	a.Equal(clonedQuery.String(), "t2 AS (SELECT 1)")
+}
+
+func TestCTEBuilderTableListPerQuery(t *testing.T) {
+	a := assert.New(t)
+	cte := WithRecursive(
+		CTEQuery("tree", "id").AsRaw("SELECT 1 UNION ALL SELECT id + 1 FROM tree WHERE id < 3"),
+		CTETable("users").As(Select("id", "name").From("accounts")),
+		CTEQuery("vip").As(Select("user_id").From("vips")).AddToTableList(),
+	)
+	a.Equal(cte.TableNames(), []string{"tree", "users", "vip"})
+
+	sb := cte.Select("users.name").Join("tree", "tree.id = users.id").Where("vip.user_id = users.id")
+	a.Equal(sb.TableNames(), []string{"users", "vip"})
+	a.Equal(sb.String(), "WITH RECURSIVE tree (id) AS (SELECT 1 UNION ALL SELECT id + 1 FROM tree WHERE id < 3), users AS (SELECT id, name FROM accounts), vip AS (SELECT user_id FROM vips) SELECT users.name FROM users, vip JOIN tree ON tree.id = users.id WHERE vip.user_id = users.id")
+}
@@ -18,6 +18,10 @@ func CTETable(name string, cols ...string) *CTEQueryBuilder {
 }
 
 // CTEQuery creates a new CTE query builder with default flavor.
+//
+// Unlike `CTETable`, its table name is not added to the FROM clause of a `SelectBuilder`,
+// so that it can be referenced only in JOIN or WHERE.
+// Call `CTEQueryBuilder#AddToTableList` to opt in per query.
 func CTEQuery(name string, cols ...string) *CTEQueryBuilder {
 	return DefaultFlavor.NewCTEQueryBuilder().Table(name, cols...)
 }
@@ -67,6 +71,14 @@ func (ctetb *CTEQueryBuilder) As(builder Builder) *CTEQueryBuilder {
 	return ctetb
 }
 
+// AsRaw sets a raw SQL with args as the body of the CTE table.
+// The sql is compiled by `Build` with args, so `$?` and other placeholders can be used in it.
+//
+// It's useful when there is no builder to build the CTE body, e.g. a `VALUES (1), (2)` list.
+func (ctetb *CTEQueryBuilder) AsRaw(sql string, args ...interface{}) *CTEQueryBuilder {
+	return ctetb.As(Build(sql, args...))
+}
+
 // AddToTableList sets flag to add table name to table list in FROM clause of SELECT statement.
 func (ctetb *CTEQueryBuilder) AddToTableList() *CTEQueryBuilder {
 	ctetb.autoAddToTableList = true
@@ -78,6 +90,16 @@ func (ctetb *CTEQueryBuilder) ShouldAddToTableList() bool {
 	return ctetb.autoAddToTableList
 }
 
+// Clone returns a deep copy of ctetb.
+// The builder set by `As` is not copied, so changing it affects both ctetb and the clone.
+func (ctetb *CTEQueryBuilder) Clone() *CTEQueryBuilder {
+	cloned := *ctetb
+	cloned.cols = append([]string(nil), ctetb.cols...)
+	cloned.args = ctetb.args.clone()
+	cloned.injection = ctetb.injection.clone()
+	return &cloned
+}
+
 // String returns the compiled CTE string.
 func (ctetb *CTEQueryBuilder) String() string {
 	sql, _ := ctetb.Build()
@@ -14,6 +14,7 @@ const (
 	deleteMarkerAfterWhere
 	deleteMarkerAfterOrderBy
 	deleteMarkerAfterLimit
+	deleteMarkerAfterReturning
 )
 
 // NewDeleteBuilder creates a new DELETE builder.
@@ -52,6 +53,7 @@ type DeleteBuilder struct {
 	orderByCols []string
 	order       string
 	limit       int
+	returning   []string
 
 	args *Args
 
@@ -119,6 +121,12 @@ func (db *DeleteBuilder) Where(andExpr ...string) *DeleteBuilder {
 	return db
 }
 
+// WhereExpr sets expressions of WHERE in DELETE like `Where`.
+// A string in andExpr is used as it is and an `Expression` is written with its args bound.
+func (db *DeleteBuilder) WhereExpr(andExpr ...interface{}) *DeleteBuilder {
+	return db.Where(exprStrings(db.args, andExpr)...)
+}
+
 // AddWhereClause adds all clauses in the whereClause to SELECT.
 func (db *DeleteBuilder) AddWhereClause(whereClause *WhereClause) *DeleteBuilder {
 	if db.WhereClause == nil {
@@ -136,6 +144,12 @@ func (db *DeleteBuilder) OrderBy(col ...string) *DeleteBuilder {
 	return db
 }
 
+// OrderByExpr sets columns of ORDER BY in DELETE like `OrderBy`.
+// A string in col is used as it is and an `Expression` is written with its args bound.
+func (db *DeleteBuilder) OrderByExpr(col ...interface{}) *DeleteBuilder {
+	return db.OrderBy(exprStrings(db.args, col)...)
+}
+
 // Asc sets order of ORDER BY to ASC.
 func (db *DeleteBuilder) Asc() *DeleteBuilder {
 	db.order = "ASC"
@@ -157,6 +171,25 @@ func (db *DeleteBuilder) Limit(limit int) *DeleteBuilder {
 	return db
 }
 
+// Returning sets columns returned by DELETE.
+// Use "*" to return all columns, e.g. `Returning("*")`.
+//
+// It's written as "RETURNING col..." at the end of DELETE in PostgreSQL, SQLite, MariaDB and DuckDB.
+// It's ignored in other flavors.
+func (db *DeleteBuilder) Returning(col ...string) *DeleteBuilder {
+	db.returning = col
+	db.marker = deleteMarkerAfterReturning
+	return db
+}
+
+// ReturningAs adds a column returned by DELETE with an alias, e.g. "RETURNING col AS alias".
+// Unlike `Returning`, it appends the column to existing returning columns.
+func (db *DeleteBuilder) ReturningAs(col, alias string) *DeleteBuilder {
+	db.returning = append(db.returning, col+" AS "+alias)
+	db.marker = deleteMarkerAfterReturning
+	return db
+}
+
 // String returns the compiled DELETE string.
 func (db *DeleteBuilder) String() string {
 	s, _ := db.Build()
@@ -169,6 +202,42 @@ func (db *DeleteBuilder) Build() (sql string, args []interface{}) {
 	return db.BuildWithFlavor(db.args.Flavor)
 }
 
+// BuildE returns compiled DELETE string and args like `Build`.
+// Unlike `Build`, it returns an error if the DELETE is invalid, e.g. an expression built by
+// a Cond not sharing args with the builder is used. See `BuildWithFlavorStrict` for details.
+//
+// The error names the offending expression and its clause if it's found in the builder.
+func (db *DeleteBuilder) BuildE() (sql string, args []interface{}, err error) {
+	return db.BuildWithFlavorStrict(db.args.Flavor)
+}
+
+// BuildWithFlavorStrict works like `BuildWithFlavor` and validates the compiled DELETE.
+// It returns an error wrapping ErrInvalidArg if any placeholder cannot be resolved,
+// or an error wrapping ErrUnsupportedFlavor if any clause would be omitted in flavor.
+func (db *DeleteBuilder) BuildWithFlavorStrict(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}, err error) {
+	return buildStrict(db, flavor, initialArg...)
+}
+
+func (db *DeleteBuilder) checkFlavor(flavor Flavor) error {
+	if len(db.returning) > 0 {
+		switch flavor {
+		case PostgreSQL, SQLite, MariaDB, DuckDB:
+		default:
+			return unsupportedFlavorError("RETURNING", flavor)
+		}
+	}
+
+	return nil
+}
+
+func (db *DeleteBuilder) listExprs() []exprGroup {
+	if db.WhereClause == nil {
+		return nil
+	}
+
+	return clauseExprGroups("WHERE", db.WhereClause.clauses)
+}
+
 // BuildWithFlavor returns compiled DELETE string and args with flavor and initial args.
 // They can be used in `DB#Query` of package `database/sql` directly.
 func (db *DeleteBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
@@ -219,6 +288,12 @@ func (db *DeleteBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 		db.injection.WriteTo(buf, deleteMarkerAfterLimit)
 	}
 
+	if len(db.returning) > 0 && (flavor == PostgreSQL || flavor == SQLite || flavor == MariaDB || flavor == DuckDB) {
+		buf.WriteLeadingString("RETURNING ")
+		buf.WriteStrings(db.returning, ", ")
+		db.injection.WriteTo(buf, deleteMarkerAfterReturning)
+	}
+
 	return db.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
 }
 
@@ -239,3 +314,9 @@ func (db *DeleteBuilder) SQL(sql string) *DeleteBuilder {
 	db.injection.SQL(db.marker, sql)
 	return db
 }
+
+// SQLf adds an arbitrary sql built by `Build` with format and args to current position.
+// It's a shortcut of `db.SQL(db.Var(Build(format, args...)))`.
+func (db *DeleteBuilder) SQLf(format string, args ...interface{}) *DeleteBuilder {
+	return db.SQL(db.Var(Build(format, args...)))
+}
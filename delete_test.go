@@ -96,3 +96,48 @@ func TestDeleteBuilderGetFlavor(t *testing.T) {
 	flavor = dbClick.Flavor()
 	a.Equal(ClickHouse, flavor)
 }
+
+func ExampleDeleteBuilder_Returning() {
+	db := MariaDB.NewDeleteBuilder()
+	db.DeleteFrom("user")
+	db.Where(db.LessThan("last_login_at", 1234567890))
+	db.Returning("id", "name")
+
+	sql, args := db.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// DELETE FROM user WHERE last_login_at < ? RETURNING id, name
+	// [1234567890]
+}
+
+func TestDeleteBuilderReturning(t *testing.T) {
+	a := assert.New(t)
+	db := newDeleteBuilder()
+	db.DeleteFrom("user").Returning("id")
+	db.SQL("/* after returning */")
+
+	a.Equal(db.String(), "DELETE FROM user")
+
+	sql, _ := db.BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "DELETE FROM user RETURNING id /* after returning */")
+
+	db = PostgreSQL.NewDeleteBuilder()
+	db.DeleteFrom("user").Returning("*")
+	a.Equal(db.String(), "DELETE FROM user RETURNING *")
+
+	db.Returning("id").ReturningAs("name", "old_name")
+	a.Equal(db.String(), "DELETE FROM user RETURNING id, name AS old_name")
+}
+
+func TestDeleteBuilderSQLf(t *testing.T) {
+	a := assert.New(t)
+	db := NewDeleteBuilder()
+	db.DeleteFrom("t").Where(db.Equal("a", 1))
+	db.SQLf("AND b IN ($?)", List([]int{2, 3}))
+
+	sql, args := db.Build()
+	a.Equal(sql, "DELETE FROM t WHERE a = ? AND b IN (?, ?)")
+	a.Equal(args, []interface{}{1, 2, 3})
+}
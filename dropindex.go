@@ -0,0 +1,124 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+const (
+	dropIndexMarkerInit injectionMarker = iota
+	dropIndexMarkerAfterDrop
+	dropIndexMarkerAfterOn
+)
+
+// NewDropIndexBuilder creates a new DROP INDEX builder.
+func NewDropIndexBuilder() *DropIndexBuilder {
+	return DefaultFlavor.NewDropIndexBuilder()
+}
+
+func newDropIndexBuilder() *DropIndexBuilder {
+	return &DropIndexBuilder{
+		args:      &Args{},
+		injection: newInjection(),
+		marker:    dropIndexMarkerInit,
+	}
+}
+
+// DropIndexBuilder is a builder to build DROP INDEX.
+type DropIndexBuilder struct {
+	ifExists bool
+	name     string
+	table    string
+
+	args *Args
+
+	injection *injection
+	marker    injectionMarker
+}
+
+var _ Builder = new(DropIndexBuilder)
+
+// DropIndex sets the index name in DROP INDEX.
+func DropIndex(name string) *DropIndexBuilder {
+	return DefaultFlavor.NewDropIndexBuilder().DropIndex(name)
+}
+
+// DropIndex sets the index name in DROP INDEX.
+func (dib *DropIndexBuilder) DropIndex(name string) *DropIndexBuilder {
+	dib.name = Escape(name)
+	dib.marker = dropIndexMarkerAfterDrop
+	return dib
+}
+
+// IfExists adds IF EXISTS before the index name in DROP INDEX.
+// It's omitted in MySQL and Oracle which don't support it.
+func (dib *DropIndexBuilder) IfExists() *DropIndexBuilder {
+	dib.ifExists = true
+	return dib
+}
+
+// On sets the table of the index.
+//
+// It's written as "DROP INDEX name ON table" in MySQL, MariaDB and SQLServer, which require the table.
+// It's omitted in other flavors as index names are unique in a schema.
+func (dib *DropIndexBuilder) On(table string) *DropIndexBuilder {
+	dib.table = Escape(table)
+	dib.marker = dropIndexMarkerAfterOn
+	return dib
+}
+
+// String returns the compiled DROP INDEX string.
+func (dib *DropIndexBuilder) String() string {
+	s, _ := dib.Build()
+	return s
+}
+
+// Build returns compiled DROP INDEX string and args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (dib *DropIndexBuilder) Build() (sql string, args []interface{}) {
+	return dib.BuildWithFlavor(dib.args.Flavor)
+}
+
+// BuildWithFlavor returns compiled DROP INDEX string and args with flavor and initial args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (dib *DropIndexBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	buf := newStringBuilder()
+	dib.injection.WriteTo(buf, dropIndexMarkerInit)
+
+	if len(dib.name) > 0 {
+		buf.WriteLeadingString("DROP INDEX")
+
+		if dib.ifExists && flavor != MySQL && flavor != Oracle {
+			buf.WriteString(" IF EXISTS")
+		}
+
+		buf.WriteRune(' ')
+		buf.WriteString(dib.name)
+	}
+
+	dib.injection.WriteTo(buf, dropIndexMarkerAfterDrop)
+
+	if len(dib.table) > 0 && (flavor.isMySQLCompatible() || flavor == SQLServer) {
+		buf.WriteLeadingString("ON ")
+		buf.WriteString(dib.table)
+		dib.injection.WriteTo(buf, dropIndexMarkerAfterOn)
+	}
+
+	return dib.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+}
+
+// SetFlavor sets the flavor of compiled sql.
+func (dib *DropIndexBuilder) SetFlavor(flavor Flavor) (old Flavor) {
+	old = dib.args.Flavor
+	dib.args.Flavor = flavor
+	return
+}
+
+// Flavor returns flavor of builder
+func (dib *DropIndexBuilder) Flavor() Flavor {
+	return dib.args.Flavor
+}
+
+// SQL adds an arbitrary sql to current position.
+func (dib *DropIndexBuilder) SQL(sql string) *DropIndexBuilder {
+	dib.injection.SQL(dib.marker, sql)
+	return dib
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleDropIndex() {
+	dib := DropIndex("idx_user_name").IfExists().On("demo.user")
+
+	fmt.Println(dib.BuildWithFlavor(PostgreSQL))
+	fmt.Println(dib.BuildWithFlavor(MySQL))
+
+	// Output:
+	// DROP INDEX IF EXISTS idx_user_name []
+	// DROP INDEX idx_user_name ON demo.user []
+}
+
+func TestDropIndexBuilderFlavors(t *testing.T) {
+	a := assert.New(t)
+	dib := DropIndex("idx").On("t")
+
+	cases := map[Flavor]string{
+		MySQL:      "DROP INDEX idx ON t",
+		SQLServer:  "DROP INDEX idx ON t",
+		PostgreSQL: "DROP INDEX idx",
+		SQLite:     "DROP INDEX idx",
+	}
+
+	for flavor, expected := range cases {
+		sql, _ := dib.BuildWithFlavor(flavor)
+		a.Equal(sql, expected)
+	}
+
+	dib = NewDropIndexBuilder()
+	dib.SQL("/* before */")
+	dib.DropIndex("idx")
+	dib.SQL("/* after drop */")
+	a.Equal(dib.String(), "/* before */ DROP INDEX idx /* after drop */")
+
+	a.Equal(NewDropIndexBuilder().String(), "")
+
+	dibPg := PostgreSQL.NewDropIndexBuilder()
+	a.Equal(PostgreSQL, dibPg.Flavor())
+}
@@ -0,0 +1,127 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+const (
+	dropTableMarkerInit injectionMarker = iota
+	dropTableMarkerAfterDrop
+	dropTableMarkerAfterOption
+)
+
+// NewDropTableBuilder creates a new DROP TABLE builder.
+func NewDropTableBuilder() *DropTableBuilder {
+	return DefaultFlavor.NewDropTableBuilder()
+}
+
+func newDropTableBuilder() *DropTableBuilder {
+	return &DropTableBuilder{
+		args:      &Args{},
+		injection: newInjection(),
+		marker:    dropTableMarkerInit,
+	}
+}
+
+// DropTableBuilder is a builder to build DROP TABLE.
+type DropTableBuilder struct {
+	ifExists bool
+	tables   []string
+	cascade  bool
+
+	args *Args
+
+	injection *injection
+	marker    injectionMarker
+}
+
+var _ Builder = new(DropTableBuilder)
+
+// DropTable sets table names in DROP TABLE.
+func DropTable(table ...string) *DropTableBuilder {
+	return DefaultFlavor.NewDropTableBuilder().DropTable(table...)
+}
+
+// DropTable sets table names in DROP TABLE.
+func (dtb *DropTableBuilder) DropTable(table ...string) *DropTableBuilder {
+	dtb.tables = EscapeAll(table...)
+	dtb.marker = dropTableMarkerAfterDrop
+	return dtb
+}
+
+// IfExists adds IF EXISTS before table names in DROP TABLE.
+func (dtb *DropTableBuilder) IfExists() *DropTableBuilder {
+	dtb.ifExists = true
+	return dtb
+}
+
+// Cascade adds CASCADE to drop objects depending on the tables.
+//
+// It's written as "CASCADE CONSTRAINTS" in Oracle.
+// It's only written in PostgreSQL, DuckDB and Oracle and omitted in other flavors.
+func (dtb *DropTableBuilder) Cascade() *DropTableBuilder {
+	dtb.cascade = true
+	dtb.marker = dropTableMarkerAfterOption
+	return dtb
+}
+
+// String returns the compiled DROP TABLE string.
+func (dtb *DropTableBuilder) String() string {
+	s, _ := dtb.Build()
+	return s
+}
+
+// Build returns compiled DROP TABLE string and args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (dtb *DropTableBuilder) Build() (sql string, args []interface{}) {
+	return dtb.BuildWithFlavor(dtb.args.Flavor)
+}
+
+// BuildWithFlavor returns compiled DROP TABLE string and args with flavor and initial args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (dtb *DropTableBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	buf := newStringBuilder()
+	dtb.injection.WriteTo(buf, dropTableMarkerInit)
+
+	if len(dtb.tables) > 0 {
+		buf.WriteLeadingString("DROP TABLE")
+
+		if dtb.ifExists {
+			buf.WriteString(" IF EXISTS")
+		}
+
+		buf.WriteRune(' ')
+		buf.WriteStrings(dtb.tables, ", ")
+	}
+
+	dtb.injection.WriteTo(buf, dropTableMarkerAfterDrop)
+
+	if dtb.cascade {
+		switch flavor {
+		case PostgreSQL, DuckDB:
+			buf.WriteLeadingString("CASCADE")
+		case Oracle:
+			buf.WriteLeadingString("CASCADE CONSTRAINTS")
+		}
+	}
+
+	dtb.injection.WriteTo(buf, dropTableMarkerAfterOption)
+	return dtb.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+}
+
+// SetFlavor sets the flavor of compiled sql.
+func (dtb *DropTableBuilder) SetFlavor(flavor Flavor) (old Flavor) {
+	old = dtb.args.Flavor
+	dtb.args.Flavor = flavor
+	return
+}
+
+// Flavor returns flavor of builder
+func (dtb *DropTableBuilder) Flavor() Flavor {
+	return dtb.args.Flavor
+}
+
+// SQL adds an arbitrary sql to current position.
+func (dtb *DropTableBuilder) SQL(sql string) *DropTableBuilder {
+	dtb.injection.SQL(dtb.marker, sql)
+	return dtb
+}
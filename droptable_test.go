@@ -0,0 +1,52 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleDropTable() {
+	sql := DropTable("demo.user").IfExists().String()
+	fmt.Println(sql)
+
+	// Output:
+	// DROP TABLE IF EXISTS demo.user
+}
+
+func ExampleDropTableBuilder_SQL() {
+	dtb := NewDropTableBuilder()
+	dtb.SQL("/* before */")
+	dtb.DropTable("demo.user")
+	dtb.SQL("/* after drop */")
+
+	fmt.Println(dtb)
+
+	// Output:
+	// /* before */ DROP TABLE demo.user /* after drop */
+}
+
+func TestDropTableBuilderFlavors(t *testing.T) {
+	a := assert.New(t)
+	dtb := DropTable("t1", "t2").Cascade()
+
+	cases := map[Flavor]string{
+		MySQL:      "DROP TABLE t1, t2",
+		PostgreSQL: "DROP TABLE t1, t2 CASCADE",
+		Oracle:     "DROP TABLE t1, t2 CASCADE CONSTRAINTS",
+	}
+
+	for flavor, expected := range cases {
+		sql, _ := dtb.BuildWithFlavor(flavor)
+		a.Equal(sql, expected)
+	}
+
+	a.Equal(NewDropTableBuilder().String(), "")
+
+	dtbPg := PostgreSQL.NewDropTableBuilder()
+	a.Equal(PostgreSQL, dtbPg.Flavor())
+}
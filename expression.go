@@ -0,0 +1,122 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+// Expression is a SQL expression with args bound to "?" in its format.
+// It's created by `Expr` or `Cond#Expr`.
+//
+// An Expression can be used as a value in all Cond methods, e.g. `Like`, `Equal`, `In` and `Between`,
+// and in `UpdateBuilder#Assign`. It's written as it is instead of being bound as a value.
+// Use `Cond#Compare` to put an Expression on the left side, e.g. `sb.Compare(expr, "<", 10)`,
+// or pass `Var` of the builder as the field of other Cond methods, e.g. `sb.Equal(sb.Var(expr), 10)`.
+//
+// Builders accept Expressions directly in methods with an "Expr" suffix, which take strings and
+// Expressions mixed, e.g. `SelectBuilder#SelectExpr`, `SelectBuilder#WhereExpr`, `SelectBuilder#GroupByExpr`,
+// `SelectBuilder#OrderByExpr` and `UpdateBuilder#SetExpr`.
+// An Expression can also be used where a string is expected by `Var` of the builder,
+// e.g. `sb.Select("id", sb.Var(expr))`.
+//
+// Args of an Expression are bound into the enclosing builder's args when the builder is compiled.
+// Every time the Expression is written, its args are bound again with new placeholders.
+// For instance, an Expression "LOWER(?)" with arg "A" used twice in PostgreSQL
+// is written as "LOWER($1)" and "LOWER($2)" with args ["A", "A"].
+type Expression struct {
+	format string
+	args   []interface{}
+}
+
+var _ Builder = Expression{}
+
+// Expr creates an Expression.
+//
+// Every "?" in format is replaced by a placeholder bound to the arg in order.
+// The "?" in string literals and quoted identifiers is not touched.
+// An arg can be any value accepted by `Args`, e.g. a `Builder`, `Raw`, `List` or another Expression.
+// A `Builder` arg is a subquery and is wrapped by parentheses.
+//
+// If there are more "?" than args, extra "?" are not bound and written as "/* INVALID ARG $? */",
+// which makes `BuildE` and `BuildWithFlavorStrict` of builders return an error wrapping ErrInvalidArg.
+//
+// For instance, `sb.Like("name", Expr("CONCAT('%', ?, '%')", term))`
+// is compiled to "name LIKE CONCAT('%', ?, '%')" with term bound.
+func Expr(format string, arg ...interface{}) Expression {
+	return Expression{
+		format: format,
+		args:   arg,
+	}
+}
+
+// String returns the compiled expression with DefaultFlavor.
+func (e Expression) String() string {
+	s, _ := e.Build()
+	return s
+}
+
+// Build returns compiled expression and args with DefaultFlavor.
+func (e Expression) Build() (sql string, args []interface{}) {
+	return e.BuildWithFlavor(DefaultFlavor)
+}
+
+// BuildWithFlavor returns compiled expression and args with flavor and initial args.
+func (e Expression) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	a := &Args{
+		Flavor: flavor,
+	}
+	return a.CompileWithFlavor(a.Add(e), flavor, initialArg...)
+}
+
+// Flavor returns DefaultFlavor as an Expression doesn't have its own flavor.
+func (e Expression) Flavor() Flavor {
+	return DefaultFlavor
+}
+
+// invalidExprArg is written for a "?" in an Expression without corresponding arg.
+// It's reported as ErrInvalidArg by `BuildWithFlavorStrict` of builders.
+const invalidExprArg = invalidArgPrefix + "? */"
+
+// writeTo writes the expression to ctx and binds args to placeholders.
+func (e Expression) writeTo(ctx *argsCompileContext) {
+	rest, _ := replaceQuestionMarks(e.format, len(e.format), func(buf []byte, cnt int) ([]byte, error) {
+		ctx.Write(buf)
+
+		if cnt >= len(e.args) {
+			ctx.WriteString(invalidExprArg)
+			return buf[:0], nil
+		}
+
+		switch arg := e.args[cnt].(type) {
+		case Expression:
+			ctx.WriteValue(arg)
+
+		case Builder:
+			// A nested builder, e.g. a SELECT, is a subquery in an expression.
+			ctx.WriteRune('(')
+			ctx.WriteValue(arg)
+			ctx.WriteRune(')')
+
+		default:
+			ctx.WriteValue(arg)
+		}
+
+		return buf[:0], nil
+	})
+	ctx.WriteString(rest)
+}
+
+// exprStrings converts values to strings which can be used in a builder owning args.
+// A string is used as it is. Other values, e.g. an Expression, are added to args and referenced by "$n".
+func exprStrings(args *Args, values []interface{}) []string {
+	strs := make([]string, 0, len(values))
+
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			strs = append(strs, s)
+			continue
+		}
+
+		strs = append(strs, args.Add(v))
+	}
+
+	return strs
+}
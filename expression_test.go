@@ -0,0 +1,117 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleExpr() {
+	distance := Expr("ST_Distance(location, ST_MakePoint(?, ?))", 121.47, 31.23)
+
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("id", sb.Var(distance)+" AS distance")
+	sb.From("shops")
+	sb.Where(
+		sb.Like("name", Expr("CONCAT('%', ?, '%')", "coffee")),
+		sb.Compare(distance, "<", 1000),
+	)
+	sb.OrderBy(sb.Var(distance))
+
+	sql, args := sb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT id, ST_Distance(location, ST_MakePoint($1, $2)) AS distance FROM shops WHERE name LIKE CONCAT('%', $3, '%') AND ST_Distance(location, ST_MakePoint($4, $5)) < $6 ORDER BY ST_Distance(location, ST_MakePoint($7, $8))
+	// [121.47 31.23 coffee 121.47 31.23 1000 121.47 31.23]
+}
+
+func TestExpression(t *testing.T) {
+	a := assert.New(t)
+	lower := Expr("LOWER(?)", "A")
+
+	a.Equal(lower.String(), "LOWER(?)")
+	sql, args := lower.BuildWithFlavor(SQLServer, 1)
+	a.Equal(sql, "LOWER(@p2)")
+	a.Equal(args, []interface{}{1, "A"})
+	a.Equal(lower.Flavor(), DefaultFlavor)
+
+	// The same Expression binds its args every time it's written.
+	sb := PostgreSQL.NewSelectBuilder()
+	v := sb.Var(lower)
+	sb.Select(v).From("t").Where(sb.Equal("name", lower), v+" <> ''")
+	sb.GroupBy(v).Having(sb.Compare(Expr("COUNT(?)", Raw("*")), ">", 1))
+	sql, args = sb.Build()
+	a.Equal(sql, "SELECT LOWER($1) FROM t WHERE name = LOWER($2) AND LOWER($3) <> '' GROUP BY LOWER($4) HAVING COUNT(*) > $5")
+	a.Equal(args, []interface{}{"A", "A", "A", "A", 1})
+
+	// A var ref of Expression can be used as a field.
+	sb = MySQL.NewSelectBuilder()
+	sb.Select("*").From("t").Where(sb.Equal(sb.Var(lower), "a"))
+	sql, args = sb.Build()
+	a.Equal(sql, "SELECT * FROM t WHERE LOWER(?) = ?")
+	a.Equal(args, []interface{}{"A", "a"})
+
+	// Nested expressions and builders.
+	ub := Update("t")
+	ub.Set(ub.Assign("v", Expr("COALESCE(?, ?) + ?", Expr("ABS(?)", -1), Buildf("SELECT MAX(id) FROM t2 WHERE id < %v", 10), 2)))
+	sql, args = ub.Build()
+	a.Equal(sql, "UPDATE t SET v = COALESCE(ABS(?), (SELECT MAX(id) FROM t2 WHERE id < ?)) + ?")
+	a.Equal(args, []interface{}{-1, 10, 2})
+
+	// Extra question marks are not bound.
+	ub = Update("t")
+	ub.Set(ub.Assign("v", Expr("? + ?", 1)))
+	sql, args = ub.Build()
+	a.Equal(sql, "UPDATE t SET v = ? + /* INVALID ARG $? */")
+	a.Equal(args, []interface{}{1})
+
+	_, _, err := ub.BuildE()
+	a.Assert(errors.Is(err, ErrInvalidArg))
+
+	// Cond#Expr is the same as Expr.
+	cond := NewCond()
+	a.Equal(cond.Expr("NOW()"), Expr("NOW()"))
+}
+
+func ExampleSelectBuilder_SelectExpr() {
+	lower := Expr("LOWER(?)", Raw("name"))
+
+	sb := NewSelectBuilder()
+	sb.SelectExpr("id", Expr("COALESCE(nickname, ?) AS nickname", "anonymous"))
+	sb.From("users")
+	sb.WhereExpr(Expr("created_at > NOW() - INTERVAL ? DAY", 7), "status = 1")
+	sb.GroupByExpr("id", lower)
+	sb.HavingExpr(Expr("COUNT(*) > ?", 1))
+	sb.OrderByExpr(Expr("FIELD(id, ?)", List([]int{3, 1, 2})))
+
+	sql, args := sb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT id, COALESCE(nickname, ?) AS nickname FROM users WHERE created_at > NOW() - INTERVAL ? DAY AND status = 1 GROUP BY id, LOWER(name) HAVING COUNT(*) > ? ORDER BY FIELD(id, ?, ?, ?)
+	// [anonymous 7 1 3 1 2]
+}
+
+func TestExpressionInBuilders(t *testing.T) {
+	a := assert.New(t)
+	ub := PostgreSQL.NewUpdateBuilder()
+	ub.Update("t").SetExpr(Expr("v = v + ?", 1), "updated_at = NOW()")
+	ub.WhereExpr(Expr("id = ?", 2)).OrderByExpr(Expr("ABS(v - ?)", 3))
+	sql, args := ub.Build()
+	a.Equal(sql, "UPDATE t SET v = v + $1, updated_at = NOW() WHERE id = $2 ORDER BY ABS(v - $3)")
+	a.Equal(args, []interface{}{1, 2, 3})
+
+	db := DeleteFrom("t")
+	db.WhereExpr(Expr("id IN ?", Select("id").From("t2"))).OrderByExpr("id").Limit(1)
+	sql, args = db.Build()
+	a.Equal(sql, "DELETE FROM t WHERE id IN (SELECT id FROM t2) ORDER BY id LIMIT 1")
+	a.Equal(len(args), 0)
+}
@@ -21,6 +21,9 @@ const (
 	Presto
 	Oracle
 	Informix
+	ANSI
+	MariaDB
+	DuckDB
 )
 
 var (
@@ -37,6 +40,7 @@ var (
 	ErrInterpolateMissingArgs = errors.New("go-sqlbuilder: not enough args when interpolating")
 
 	// ErrInterpolateUnsupportedArgs means that some types of the args are not supported.
+	// Interpolate wraps it with the index and type of the unsupported arg, so use `errors.Is` to check it.
 	ErrInterpolateUnsupportedArgs = errors.New("go-sqlbuilder: unsupported args when interpolating")
 )
 
@@ -64,11 +68,34 @@ func (f Flavor) String() string {
 		return "Oracle"
 	case Informix:
 		return "Informix"
+	case ANSI:
+		return "ANSI"
+	case MariaDB:
+		return "MariaDB"
+	case DuckDB:
+		return "DuckDB"
 	}
 
 	return "<invalid>"
 }
 
+// isNumberedPlaceholder returns true if the placeholders of f refer args by position,
+// e.g. "$1" in PostgreSQL, so that the same placeholder can be used more than once.
+func (f Flavor) isNumberedPlaceholder() bool {
+	switch f {
+	case PostgreSQL, SQLServer:
+		return true
+	}
+
+	return false
+}
+
+// isMySQLCompatible returns true if f shares the syntax of MySQL,
+// e.g. MariaDB, which is a fork of MySQL.
+func (f Flavor) isMySQLCompatible() bool {
+	return f == MySQL || f == MariaDB
+}
+
 // Interpolate parses sql returned by `Args#Compile` or `Builder`,
 // and interpolate args to replace placeholders in the sql.
 //
@@ -94,11 +121,38 @@ func (f Flavor) Interpolate(sql string, args []interface{}) (string, error) {
 		return oracleInterpolate(sql, args...)
 	case Informix:
 		return informixInterpolate(sql, args...)
+	case ANSI:
+		return ansiInterpolate(sql, args...)
+	case MariaDB:
+		return mariadbInterpolate(sql, args...)
+	case DuckDB:
+		return duckdbInterpolate(sql, args...)
 	}
 
 	return "", ErrInterpolateNotImplemented
 }
 
+// InterpolateTruncated works like `Interpolate` except that string and byte slice values
+// longer than maxLen bytes are truncated.
+// A truncated string is written like 'abc...(1234 bytes)' and a truncated byte slice is
+// written like X'616263' /* ...(1234 bytes) */, where 1234 is the length of the original value.
+//
+// The result is for logging only. Never execute it.
+// If maxLen is not positive, values are not truncated.
+func (f Flavor) InterpolateTruncated(sql string, args []interface{}, maxLen int) (string, error) {
+	if maxLen > 0 {
+		truncated, err := truncateArgs(f, args, maxLen)
+
+		if err != nil {
+			return "", err
+		}
+
+		args = truncated
+	}
+
+	return f.Interpolate(sql, args)
+}
+
 // NewCreateTableBuilder creates a new CREATE TABLE builder with flavor.
 func (f Flavor) NewCreateTableBuilder() *CreateTableBuilder {
 	b := newCreateTableBuilder()
@@ -155,16 +209,79 @@ func (f Flavor) NewCTEQueryBuilder() *CTEQueryBuilder {
 	return b
 }
 
+// NewValuesTableBuilder creates a new VALUES table builder with flavor.
+func (f Flavor) NewValuesTableBuilder() *ValuesTableBuilder {
+	b := newValuesTableBuilder()
+	b.SetFlavor(f)
+	return b
+}
+
+// NewMergeBuilder creates a new MERGE builder with flavor.
+func (f Flavor) NewMergeBuilder() *MergeBuilder {
+	b := newMergeBuilder()
+	b.SetFlavor(f)
+	return b
+}
+
+// NewCreateIndexBuilder creates a new CREATE INDEX builder with flavor.
+func (f Flavor) NewCreateIndexBuilder() *CreateIndexBuilder {
+	b := newCreateIndexBuilder()
+	b.SetFlavor(f)
+	return b
+}
+
+// NewDropTableBuilder creates a new DROP TABLE builder with flavor.
+func (f Flavor) NewDropTableBuilder() *DropTableBuilder {
+	b := newDropTableBuilder()
+	b.SetFlavor(f)
+	return b
+}
+
+// NewDropIndexBuilder creates a new DROP INDEX builder with flavor.
+func (f Flavor) NewDropIndexBuilder() *DropIndexBuilder {
+	b := newDropIndexBuilder()
+	b.SetFlavor(f)
+	return b
+}
+
+// NewAlterTableBuilder creates a new ALTER TABLE builder with flavor.
+func (f Flavor) NewAlterTableBuilder() *AlterTableBuilder {
+	b := newAlterTableBuilder()
+	b.SetFlavor(f)
+	return b
+}
+
+// NewTruncateBuilder creates a new TRUNCATE TABLE builder with flavor.
+func (f Flavor) NewTruncateBuilder() *TruncateBuilder {
+	b := newTruncateBuilder()
+	b.SetFlavor(f)
+	return b
+}
+
+// NewGrantBuilder creates a new GRANT builder with flavor.
+func (f Flavor) NewGrantBuilder() *GrantBuilder {
+	b := newGrantBuilder()
+	b.SetFlavor(f)
+	return b
+}
+
+// NewRevokeBuilder creates a new REVOKE builder with flavor.
+func (f Flavor) NewRevokeBuilder() *RevokeBuilder {
+	b := newRevokeBuilder()
+	b.SetFlavor(f)
+	return b
+}
+
 // Quote adds quote for name to make sure the name can be used safely
 // as table name or field name.
 //
-//   - For MySQL, use back quote (`) to quote name;
-//   - For PostgreSQL, SQL Server and SQLite, use double quote (") to quote name.
+//   - For MySQL and MariaDB, use back quote (`) to quote name;
+//   - For PostgreSQL, SQL Server, SQLite, ANSI and DuckDB, use double quote (") to quote name.
 func (f Flavor) Quote(name string) string {
 	switch f {
-	case MySQL, ClickHouse:
+	case MySQL, MariaDB, ClickHouse:
 		return fmt.Sprintf("`%s`", name)
-	case PostgreSQL, SQLServer, SQLite, Presto, Oracle, Informix:
+	case PostgreSQL, SQLServer, SQLite, Presto, Oracle, Informix, ANSI, DuckDB:
 		return fmt.Sprintf(`"%s"`, name)
 	case CQL:
 		return fmt.Sprintf("'%s'", name)
@@ -176,7 +293,7 @@ func (f Flavor) Quote(name string) string {
 // PrepareInsertIgnore prepares the insert builder to build insert ignore SQL statement based on the sql flavor
 func (f Flavor) PrepareInsertIgnore(table string, ib *InsertBuilder) {
 	switch ib.args.Flavor {
-	case MySQL, Oracle:
+	case MySQL, MariaDB, Oracle:
 		ib.verb = "INSERT IGNORE"
 
 	case PostgreSQL:
@@ -186,11 +303,11 @@ func (f Flavor) PrepareInsertIgnore(table string, ib *InsertBuilder) {
 		ib.marker = insertMarkerAfterValues
 		ib.SQL("ON CONFLICT DO NOTHING")
 
-	case SQLite:
+	case SQLite, DuckDB:
 		// see https://www.sqlite.org/lang_insert.html
 		ib.verb = "INSERT OR IGNORE"
 
-	case ClickHouse, CQL, SQLServer, Presto, Informix:
+	case ClickHouse, CQL, SQLServer, Presto, Informix, ANSI:
 		// All other databases do not support insert ignore
 		ib.verb = "INSERT"
 
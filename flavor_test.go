@@ -22,6 +22,9 @@ func TestFlavor(t *testing.T) {
 		ClickHouse: "ClickHouse",
 		Oracle:     "Oracle",
 		Informix:   "Informix",
+		ANSI:       "ANSI",
+		MariaDB:    "MariaDB",
+		DuckDB:     "DuckDB",
 	}
 
 	for f, expected := range cases {
@@ -178,3 +181,48 @@ func ExampleFlavor_Interpolate_infomix() {
 	// SELECT name FROM user WHERE id <> 1234 AND name = 'Charmy Liu' AND enabled = TRUE
 	// <nil>
 }
+
+func TestFlavorMariaDB(t *testing.T) {
+	a := assert.New(t)
+	a.Equal(MariaDB.Quote("name"), "`name`")
+
+	ib := MariaDB.NewInsertBuilder()
+	ib.InsertInto("user").Cols("id", "name").Values(1, "Huan Du").Returning("id")
+	ib.OnConflict("id").DoUpdateSet("name = " + ib.Excluded("name"))
+	sql, args := ib.Build()
+	a.Equal(sql, "INSERT INTO user (id, name) VALUES (?, ?) ON DUPLICATE KEY UPDATE name = VALUES(name) RETURNING id")
+	a.Equal(args, []interface{}{1, "Huan Du"})
+
+	sb := MariaDB.NewSelectBuilder()
+	sb.Select("*").From("user").Where(sb.RegexpIMatch("name", "^huan")).Limit(10).Offset(5)
+	sql, args = sb.Build()
+	a.Equal(sql, "SELECT * FROM user WHERE name REGEXP CONCAT('(?i)', ?) LIMIT 10 OFFSET 5")
+	a.Equal(args, []interface{}{"^huan"})
+
+	a.Equal(MariaDB.NewDeleteBuilder().Flavor(), MariaDB)
+	a.Equal(MariaDB.NewUpdateBuilder().Flavor(), MariaDB)
+}
+
+func TestFlavorDuckDB(t *testing.T) {
+	a := assert.New(t)
+	a.Equal(DuckDB.Quote("name"), `"name"`)
+
+	sb := DuckDB.NewSelectBuilder()
+	sb.Select("city", "SUM(amount)").From("sales").Where(sb.ILike("city", "s%")).GroupByAll()
+	sql, args := sb.Build()
+	a.Equal(sql, "SELECT city, SUM(amount) FROM sales WHERE city ILIKE ? GROUP BY ALL")
+	a.Equal(args, []interface{}{"s%"})
+
+	ib := DuckDB.NewInsertBuilder()
+	ib.InsertInto("t").Cols("id", "v").Values(1, 2).Returning("id")
+	ib.OnConflict("id").DoUpdateSet("v = " + ib.Excluded("v"))
+	sql, _ = ib.Build()
+	a.Equal(sql, "INSERT INTO t (id, v) VALUES (?, ?) ON CONFLICT (id) DO UPDATE SET v = EXCLUDED.v RETURNING id")
+
+	ib = DuckDB.NewInsertBuilder()
+	DuckDB.PrepareInsertIgnore("t", ib)
+	a.Equal(ib.InsertInto("t").Cols("id").Values(1).String(), "INSERT OR IGNORE INTO t (id) VALUES (?)")
+
+	a.Equal(DuckDB.NewDeleteBuilder().DeleteFrom("t").Returning("id").String(), "DELETE FROM t RETURNING id")
+	a.Equal(DuckDB.NewUpdateBuilder().Flavor(), DuckDB)
+}
@@ -0,0 +1,429 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"strings"
+)
+
+// FormatOptions controls how to format SQL in `SelectBuilder#BuildPretty`.
+//
+// Keywords written by builders are always in upper case.
+// Set UppercaseKeywords to convert keywords in expressions set by callers to upper case as well.
+// Nested builders, e.g. subqueries and CTE, are built in compact form.
+type FormatOptions struct {
+	// Indent is written before every JOIN clause and every condition in WHERE except the first one
+	// when the corresponding newline option is set.
+	Indent string
+
+	// NewlineBetweenClauses puts every clause, e.g. FROM, JOIN, WHERE, GROUP BY, on its own line.
+	NewlineBetweenClauses bool
+
+	// NewlineBetweenConditions puts every AND condition in WHERE on its own line.
+	NewlineBetweenConditions bool
+
+	// UppercaseKeywords converts common SQL keywords, e.g. "select", "and" and "is null", to upper case
+	// in the whole SQL including expressions set by callers and nested builders.
+	// Quoted strings, quoted identifiers, comments and qualified names like "t.order" are not changed.
+	UppercaseKeywords bool
+}
+
+// DefaultFormatOptions is a set of options to format SQL in a human-readable way.
+var DefaultFormatOptions = FormatOptions{
+	Indent:                   "  ",
+	NewlineBetweenClauses:    true,
+	NewlineBetweenConditions: true,
+	UppercaseKeywords:        true,
+}
+
+// writeClause writes a clause to buf.
+// If opts is nil, it's the same as `stringBuilder#WriteLeadingString`.
+func (opts *FormatOptions) writeClause(buf *stringBuilder, indented bool, s string) {
+	if opts == nil || !opts.NewlineBetweenClauses {
+		buf.WriteLeadingString(s)
+		return
+	}
+
+	if buf.Len() > 0 {
+		buf.WriteRune('\n')
+	}
+
+	if indented {
+		buf.WriteString(opts.Indent)
+	}
+
+	buf.WriteString(s)
+}
+
+// andSep returns the separator between AND conditions in WHERE.
+// An empty string means the default separator.
+func (opts *FormatOptions) andSep() string {
+	if opts == nil || !opts.NewlineBetweenConditions {
+		return ""
+	}
+
+	return "\n" + opts.Indent + "AND "
+}
+
+// uppercaseKeywordSet is the set of keywords converted to upper case by `FormatOptions#UppercaseKeywords`.
+var uppercaseKeywordSet = map[string]bool{}
+
+func init() {
+	for _, kw := range strings.Fields(`ALL AND AS ASC BETWEEN BY CASE CROSS DELETE DESC DISTINCT ELSE END EXCEPT EXISTS
+		FALSE FROM FULL GROUP HAVING ILIKE IN INNER INSERT INTERSECT INTO IS JOIN LEFT LIKE LIMIT NATURAL NOT NULL
+		OFFSET ON OR ORDER OUTER OVER PARTITION RETURNING RIGHT SELECT SET THEN TRUE UNION UPDATE USING VALUES
+		WHEN WHERE WINDOW WITH`) {
+		uppercaseKeywordSet[kw] = true
+	}
+}
+
+// uppercaseKeywords converts keywords in `uppercaseKeywordSet` in sql to upper case.
+func uppercaseKeywords(sql string) string {
+	tokens := tokenizeForFormat(sql)
+	buf := newStringBuilder()
+	buf.Grow(len(sql))
+
+	for _, token := range tokens {
+		if token.kind == formatTokenWord {
+			if upper := strings.ToUpper(token.text); uppercaseKeywordSet[upper] {
+				buf.WriteString(upper)
+				continue
+			}
+		}
+
+		buf.WriteString(token.text)
+	}
+
+	return buf.String()
+}
+
+// formatIndent is the indent of subqueries written by `Format`.
+const formatIndent = "  "
+
+// formatKeywords are keywords which start a new line in `Format`.
+// Longer keywords must be put before shorter ones sharing the same prefix.
+var formatKeywords = [][]string{
+	{"LEFT", "OUTER", "JOIN"},
+	{"RIGHT", "OUTER", "JOIN"},
+	{"FULL", "OUTER", "JOIN"},
+	{"LEFT", "JOIN"},
+	{"RIGHT", "JOIN"},
+	{"FULL", "JOIN"},
+	{"INNER", "JOIN"},
+	{"CROSS", "JOIN"},
+	{"NATURAL", "JOIN"},
+	{"JOIN"},
+	{"ON", "DUPLICATE", "KEY", "UPDATE"},
+	{"ON", "CONFLICT"},
+	{"UNION", "ALL"},
+	{"UNION"},
+	{"INTERSECT"},
+	{"EXCEPT"},
+	{"SELECT"},
+	{"FROM"},
+	{"WHERE"},
+	{"GROUP", "BY"},
+	{"HAVING"},
+	{"WINDOW"},
+	{"ORDER", "BY"},
+	{"LIMIT"},
+	{"INSERT", "INTO"},
+	{"VALUES"},
+	{"UPDATE"},
+	{"SET"},
+	{"DELETE", "FROM"},
+	{"RETURNING"},
+}
+
+type formatTokenKind int
+
+const (
+	formatTokenWord formatTokenKind = iota
+	formatTokenSpace
+	formatTokenQuoted
+	formatTokenComment
+	formatTokenPunct
+)
+
+// formatParen is a level of parentheses in `Format`.
+type formatParen struct {
+	isSubquery bool
+	lineIndent int // The indent of the line opening the parenthesis.
+	depth      int // The indent of new lines before the parenthesis.
+}
+
+type formatToken struct {
+	kind formatTokenKind
+	text string
+}
+
+// Format formats sql in a human-readable way for debugging.
+// It's independent of flavor and works with sql built by any builder.
+//
+// Format starts a new line before major keywords like SELECT, FROM, JOIN, WHERE, GROUP BY, ORDER BY and LIMIT,
+// and indents JOIN clauses and subqueries in parentheses.
+// Keywords in parentheses which are not subqueries, e.g. ORDER BY in OVER (...), are not affected.
+// It only changes whitespaces outside quoted strings and comments. Format doesn't validate sql.
+func Format(sql string) string {
+	tokens := tokenizeForFormat(sql)
+	buf := newStringBuilder()
+
+	// The stack records every level of parentheses.
+	stack := []formatParen{{isSubquery: true}}
+	depth := 0
+	lineIndent := 0
+	lineStart := true
+	pendingSpace := false
+
+	prevWord := ""
+
+	newline := func() {
+		if buf.Len() == 0 || lineStart {
+			return
+		}
+
+		buf.WriteRune('\n')
+
+		for i := 0; i < depth; i++ {
+			buf.WriteString(formatIndent)
+		}
+
+		lineIndent = depth
+		lineStart = true
+		pendingSpace = false
+	}
+	write := func(s string) {
+		if pendingSpace && !lineStart {
+			buf.WriteRune(' ')
+		}
+
+		buf.WriteString(s)
+		lineStart = false
+		pendingSpace = false
+	}
+
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+
+		switch token.kind {
+		case formatTokenSpace:
+			pendingSpace = true
+
+		case formatTokenPunct:
+			prevWord = ""
+
+			switch token.text {
+			case "(":
+				next := nextFormatWord(tokens, i+1)
+				isSubquery := strings.EqualFold(next, "SELECT") || strings.EqualFold(next, "WITH")
+				write("(")
+				stack = append(stack, formatParen{
+					isSubquery: isSubquery,
+					lineIndent: lineIndent,
+					depth:      depth,
+				})
+
+				// A subquery is indented one level deeper than the line opening it.
+				if isSubquery {
+					depth = lineIndent + 1
+					newline()
+				}
+
+			case ")":
+				if len(stack) > 1 {
+					paren := stack[len(stack)-1]
+
+					if paren.isSubquery {
+						depth = paren.lineIndent
+						newline()
+						depth = paren.depth
+					}
+
+					stack = stack[:len(stack)-1]
+				}
+
+				write(")")
+
+			default:
+				write(token.text)
+			}
+
+		case formatTokenWord:
+			words, end := matchFormatKeyword(tokens, i)
+
+			// Keywords are not put on a new line in parentheses which are not subqueries
+			// or in expressions like "IS DISTINCT FROM" and "FOR UPDATE".
+			if len(words) == 0 || !stack[len(stack)-1].isSubquery || isFormatKeywordModifier(prevWord) {
+				prevWord = token.text
+				write(token.text)
+				break
+			}
+
+			newline()
+
+			// JOIN is indented like `DefaultFormatOptions`.
+			if strings.EqualFold(words[len(words)-1], "JOIN") {
+				write(formatIndent)
+				lineIndent++
+				lineStart = true
+			}
+
+			write(strings.Join(words, " "))
+			prevWord = words[len(words)-1]
+			i = end
+
+		case formatTokenComment:
+			// A line comment contains the trailing newline.
+			if text := strings.TrimSuffix(token.text, "\n"); text != token.text {
+				write(text)
+				newline()
+			} else {
+				write(text)
+			}
+
+		default:
+			prevWord = ""
+			write(token.text)
+		}
+	}
+
+	return buf.String()
+}
+
+// matchFormatKeyword matches a keyword in `formatKeywords` starting from tokens[start].
+// It returns words of the keyword in original case and the index of the last matched token.
+func matchFormatKeyword(tokens []formatToken, start int) (words []string, end int) {
+	for _, keyword := range formatKeywords {
+		words = words[:0]
+		i := start
+
+		for j, kw := range keyword {
+			if j > 0 {
+				if i++; i >= len(tokens) || tokens[i].kind != formatTokenSpace {
+					break
+				}
+
+				i++
+			}
+
+			if i >= len(tokens) || tokens[i].kind != formatTokenWord || !strings.EqualFold(tokens[i].text, kw) {
+				break
+			}
+
+			words = append(words, tokens[i].text)
+		}
+
+		if len(words) == len(keyword) {
+			return words, i
+		}
+	}
+
+	return nil, start
+}
+
+func isFormatKeywordModifier(word string) bool {
+	return strings.EqualFold(word, "DISTINCT") || strings.EqualFold(word, "FOR") || strings.EqualFold(word, "DO")
+}
+
+// nextFormatWord returns the first word in tokens starting from tokens[start] after whitespaces.
+func nextFormatWord(tokens []formatToken, start int) string {
+	for i := start; i < len(tokens); i++ {
+		switch tokens[i].kind {
+		case formatTokenSpace:
+			continue
+		case formatTokenWord:
+			return tokens[i].text
+		}
+
+		return ""
+	}
+
+	return ""
+}
+
+// tokenizeForFormat splits sql into tokens for `Format`.
+func tokenizeForFormat(sql string) (tokens []formatToken) {
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		start := i
+		kind := formatTokenPunct
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			kind = formatTokenSpace
+
+			for i < len(sql) && (sql[i] == ' ' || sql[i] == '\t' || sql[i] == '\n' || sql[i] == '\r') {
+				i++
+			}
+
+		case c == '\'' || c == '"' || c == '`':
+			kind = formatTokenQuoted
+			i++
+
+			for i < len(sql) {
+				if sql[i] == '\\' && c != '`' {
+					i += 2
+					continue
+				}
+
+				if sql[i] == c {
+					// A doubled quote is an escaped quote.
+					if i+1 < len(sql) && sql[i+1] == c {
+						i += 2
+						continue
+					}
+
+					i++
+					break
+				}
+
+				i++
+			}
+
+			if i > len(sql) {
+				i = len(sql)
+			}
+
+		case c == '-' && strings.HasPrefix(sql[i:], "--"):
+			kind = formatTokenComment
+
+			if n := strings.IndexByte(sql[i:], '\n'); n >= 0 {
+				i += n + 1
+			} else {
+				i = len(sql)
+			}
+
+		case c == '/' && strings.HasPrefix(sql[i:], "/*"):
+			kind = formatTokenComment
+
+			if n := strings.Index(sql[i+2:], "*/"); n >= 0 {
+				i += n + 4
+			} else {
+				i = len(sql)
+			}
+
+		case isFormatWordChar(c):
+			kind = formatTokenWord
+
+			for i < len(sql) && isFormatWordChar(sql[i]) {
+				i++
+			}
+
+		default:
+			i++
+		}
+
+		tokens = append(tokens, formatToken{
+			kind: kind,
+			text: sql[start:i],
+		})
+	}
+
+	return
+}
+
+func isFormatWordChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		c == '_' || c == '$' || c == '.' || c >= 0x80
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleFormat() {
+	sb := NewSelectBuilder()
+	sb.Select("u.id", "u.name", "ROW_NUMBER() OVER (PARTITION BY u.dept ORDER BY u.id) AS rn")
+	sb.From("users u")
+	sb.JoinWithOption(LeftJoin, "orders o", "o.user_id = u.id")
+	sb.Where(
+		sb.In("u.id", Select("user_id").From("vip").Where("level > 3")),
+		"u.name <> 'select * from users'",
+	)
+	sb.OrderBy("u.id").Limit(10)
+
+	fmt.Println(Format(sb.String()))
+
+	// Output:
+	// SELECT u.id, u.name, ROW_NUMBER() OVER (PARTITION BY u.dept ORDER BY u.id) AS rn
+	// FROM users u
+	//   LEFT JOIN orders o ON o.user_id = u.id
+	// WHERE u.id IN (
+	//   SELECT user_id
+	//   FROM vip
+	//   WHERE level > 3
+	// ) AND u.name <> 'select * from users'
+	// ORDER BY u.id
+	// LIMIT 10
+}
+
+func TestFormat(t *testing.T) {
+	a := assert.New(t)
+	cases := map[string]string{
+		"":                 "",
+		"  select  1  ":    "select 1",
+		"SELECT 1 FROM t)": "SELECT 1\nFROM t)",
+
+		"select a from t where a is distinct from b for update":  "select a\nfrom t\nwhere a is distinct from b for update",
+		"SELECT EXTRACT(YEAR FROM d) FROM t":                     "SELECT EXTRACT(YEAR FROM d)\nFROM t",
+		`SELECT "from", 'it''s where', ` + "`limit`" + ` FROM t`: "SELECT \"from\", 'it''s where', `limit`\nFROM t",
+
+		"-- comment\nDELETE FROM t WHERE id = 1 /* where */ RETURNING id":  "-- comment\nDELETE FROM t\nWHERE id = 1 /* where */\nRETURNING id",
+		"INSERT INTO t (a) VALUES (1) ON CONFLICT (a) DO UPDATE SET a = 2": "INSERT INTO t (a)\nVALUES (1)\nON CONFLICT (a) DO UPDATE\nSET a = 2",
+		"WITH c AS (SELECT 1) SELECT * FROM c":                             "WITH c AS (\n  SELECT 1\n)\nSELECT *\nFROM c",
+		"SELECT * FROM (SELECT * FROM (SELECT 1) t1) t2":                   "SELECT *\nFROM (\n  SELECT *\n  FROM (\n    SELECT 1\n  ) t1\n) t2",
+		"SELECT 'unterminated FROM t":                                      "SELECT 'unterminated FROM t",
+
+		"SELECT a FROM t JOIN (SELECT b FROM u WHERE c IN (SELECT d FROM v)) x ON x.b = t.a WHERE a = 1": "SELECT a\nFROM t\n  JOIN (\n    SELECT b\n    FROM u\n    WHERE c IN (\n      SELECT d\n      FROM v\n    )\n  ) x ON x.b = t.a\nWHERE a = 1",
+	}
+
+	for sql, expected := range cases {
+		a.Use(&sql, &expected)
+		a.Equal(Format(sql), expected)
+	}
+}
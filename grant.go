@@ -0,0 +1,180 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"strings"
+)
+
+const (
+	grantMarkerInit injectionMarker = iota
+	grantMarkerAfterGrant
+	grantMarkerAfterOn
+	grantMarkerAfterTo
+)
+
+// NewGrantBuilder creates a new GRANT builder.
+func NewGrantBuilder() *GrantBuilder {
+	return DefaultFlavor.NewGrantBuilder()
+}
+
+func newGrantBuilder() *GrantBuilder {
+	return &GrantBuilder{
+		args:      &Args{},
+		injection: newInjection(),
+	}
+}
+
+// GrantBuilder is a builder to build GRANT.
+type GrantBuilder struct {
+	privileges      []string
+	objectType      string
+	object          string
+	grantees        []string
+	withGrantOption bool
+
+	args *Args
+
+	injection *injection
+	marker    injectionMarker
+}
+
+var _ Builder = new(GrantBuilder)
+
+// Grant sets privileges in GRANT.
+func Grant(privilege ...string) *GrantBuilder {
+	return DefaultFlavor.NewGrantBuilder().Grant(privilege...)
+}
+
+// Grant sets privileges in GRANT.
+func (gb *GrantBuilder) Grant(privilege ...string) *GrantBuilder {
+	gb.privileges = privilege
+	gb.marker = grantMarkerAfterGrant
+	return gb
+}
+
+// On sets the table to grant privileges on.
+//
+// The object name is quoted by `Flavor#Quote` part by part, e.g. "db.t" is quoted as "`db`.`t`" in MySQL.
+// A wildcard part like "*" is kept as it is.
+func (gb *GrantBuilder) On(object string) *GrantBuilder {
+	return gb.OnObject("", object)
+}
+
+// OnObject sets the object to grant privileges on with an explicit object type,
+// e.g. "SCHEMA", "DATABASE" or "SEQUENCE".
+// If objectType is empty, the object is a table.
+func (gb *GrantBuilder) OnObject(objectType, object string) *GrantBuilder {
+	gb.objectType = objectType
+	gb.object = object
+	gb.marker = grantMarkerAfterOn
+	return gb
+}
+
+// To sets grantees in GRANT.
+// Grantees are not quoted, so that MySQL account names like "'user'@'host'" can be used directly.
+func (gb *GrantBuilder) To(grantee ...string) *GrantBuilder {
+	gb.grantees = grantee
+	gb.marker = grantMarkerAfterTo
+	return gb
+}
+
+// WithGrantOption adds WITH GRANT OPTION at the end of GRANT.
+func (gb *GrantBuilder) WithGrantOption() *GrantBuilder {
+	gb.withGrantOption = true
+	gb.marker = grantMarkerAfterTo
+	return gb
+}
+
+// String returns the compiled GRANT string.
+func (gb *GrantBuilder) String() string {
+	s, _ := gb.Build()
+	return s
+}
+
+// Build returns compiled GRANT string and args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (gb *GrantBuilder) Build() (sql string, args []interface{}) {
+	return gb.BuildWithFlavor(gb.args.Flavor)
+}
+
+// BuildWithFlavor returns compiled GRANT string and args with flavor and initial args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (gb *GrantBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	buf := newStringBuilder()
+	gb.injection.WriteTo(buf, grantMarkerInit)
+
+	if len(gb.privileges) > 0 {
+		buf.WriteLeadingString("GRANT ")
+		buf.WriteStrings(gb.privileges, ", ")
+	}
+
+	gb.injection.WriteTo(buf, grantMarkerAfterGrant)
+
+	if gb.object != "" {
+		buf.WriteLeadingString("ON ")
+		writePrivilegeObject(buf, flavor, gb.objectType, gb.object)
+	}
+
+	gb.injection.WriteTo(buf, grantMarkerAfterOn)
+
+	if len(gb.grantees) > 0 {
+		buf.WriteLeadingString("TO ")
+		buf.WriteStrings(gb.grantees, ", ")
+
+		if gb.withGrantOption {
+			buf.WriteString(" WITH GRANT OPTION")
+		}
+	}
+
+	gb.injection.WriteTo(buf, grantMarkerAfterTo)
+	return gb.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+}
+
+// SetFlavor sets the flavor of compiled sql.
+func (gb *GrantBuilder) SetFlavor(flavor Flavor) (old Flavor) {
+	old = gb.args.Flavor
+	gb.args.Flavor = flavor
+	return
+}
+
+// Flavor returns flavor of builder
+func (gb *GrantBuilder) Flavor() Flavor {
+	return gb.args.Flavor
+}
+
+// SQL adds an arbitrary sql to current position.
+func (gb *GrantBuilder) SQL(sql string) *GrantBuilder {
+	gb.injection.SQL(gb.marker, sql)
+	return gb
+}
+
+// writePrivilegeObject writes the object in GRANT or REVOKE.
+// PostgreSQL requires object type for non-table objects and accepts "TABLE" for tables,
+// so "TABLE" is always written for PostgreSQL to make the statement clear.
+func writePrivilegeObject(buf *stringBuilder, flavor Flavor, objectType, object string) {
+	if objectType == "" && flavor == PostgreSQL {
+		objectType = "TABLE"
+	}
+
+	if objectType != "" {
+		buf.WriteString(objectType)
+		buf.WriteRune(' ')
+	}
+
+	parts := strings.Split(object, ".")
+
+	for i, part := range parts {
+		if i > 0 {
+			buf.WriteRune('.')
+		}
+
+		if part == "*" || part == "" {
+			buf.WriteString(part)
+			continue
+		}
+
+		buf.WriteString(Escape(flavor.Quote(part)))
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleGrant() {
+	sql := Grant("SELECT", "INSERT").On("demo.user").To("'app'@'%'").String()
+
+	fmt.Println(sql)
+
+	// Output:
+	// GRANT SELECT, INSERT ON `demo`.`user` TO 'app'@'%'
+}
+
+func ExampleGrantBuilder() {
+	gb := PostgreSQL.NewGrantBuilder()
+	gb.Grant("SELECT", "UPDATE").On("public.user").To("app_role", "report_role").WithGrantOption()
+
+	fmt.Println(gb)
+
+	// Output:
+	// GRANT SELECT, UPDATE ON TABLE "public"."user" TO app_role, report_role WITH GRANT OPTION
+}
+
+func ExampleGrantBuilder_OnObject() {
+	gb := PostgreSQL.NewGrantBuilder()
+	gb.Grant("USAGE").OnObject("SCHEMA", "reporting").To("report_role")
+
+	fmt.Println(gb)
+
+	// Output:
+	// GRANT USAGE ON SCHEMA "reporting" TO report_role
+}
+
+func ExampleRevoke() {
+	sql := Revoke("ALL PRIVILEGES").On("demo.*").From("'app'@'%'").String()
+
+	fmt.Println(sql)
+
+	// Output:
+	// REVOKE ALL PRIVILEGES ON `demo`.* FROM 'app'@'%'
+}
+
+func ExampleRevokeBuilder() {
+	rb := PostgreSQL.NewRevokeBuilder()
+	rb.Revoke("SELECT").GrantOptionFor().On("public.user").From("app_role").Cascade()
+
+	fmt.Println(rb)
+
+	// Output:
+	// REVOKE GRANT OPTION FOR SELECT ON TABLE "public"."user" FROM app_role CASCADE
+}
+
+func TestGrantAndRevokeFlavors(t *testing.T) {
+	a := assert.New(t)
+	cases := map[Flavor][2]string{
+		MySQL:      {"GRANT SELECT ON `t` TO r WITH GRANT OPTION", "REVOKE SELECT, GRANT OPTION ON `t` FROM r"},
+		PostgreSQL: {`GRANT SELECT ON TABLE "t" TO r WITH GRANT OPTION`, `REVOKE GRANT OPTION FOR SELECT ON TABLE "t" FROM r CASCADE`},
+		SQLServer:  {`GRANT SELECT ON "t" TO r WITH GRANT OPTION`, `REVOKE GRANT OPTION FOR SELECT ON "t" FROM r CASCADE`},
+		SQLite:     {`GRANT SELECT ON "t" TO r WITH GRANT OPTION`, `REVOKE GRANT OPTION FOR SELECT ON "t" FROM r`},
+	}
+
+	for flavor, expected := range cases {
+		gb := flavor.NewGrantBuilder().Grant("SELECT").On("t").To("r").WithGrantOption()
+		rb := flavor.NewRevokeBuilder().Revoke("SELECT").GrantOptionFor().On("t").From("r").Cascade()
+
+		a.Equal(gb.String(), expected[0])
+		a.Equal(rb.String(), expected[1])
+	}
+}
+
+func TestGrantAndRevokeSQL(t *testing.T) {
+	a := assert.New(t)
+	gb := NewGrantBuilder()
+	gb.SQL("/* before */")
+	gb.Grant("SELECT").SQL("/* after grant */")
+	gb.On("t").SQL("/* after on */")
+	gb.To("r").SQL("/* after to */")
+	a.Equal(gb.String(), "/* before */ GRANT SELECT /* after grant */ ON `t` /* after on */ TO r /* after to */")
+
+	rb := NewRevokeBuilder()
+	rb.SQL("/* before */")
+	rb.Revoke("SELECT").SQL("/* after revoke */")
+	rb.On("t").SQL("/* after on */")
+	rb.From("r").SQL("/* after from */")
+	a.Equal(rb.String(), "/* before */ REVOKE SELECT /* after revoke */ ON `t` /* after on */ FROM r /* after from */")
+}
+
+func TestGrantAndRevokeGetFlavor(t *testing.T) {
+	a := assert.New(t)
+	gb := newGrantBuilder()
+	gb.SetFlavor(PostgreSQL)
+	a.Equal(PostgreSQL, gb.Flavor())
+	a.Equal(ClickHouse, ClickHouse.NewGrantBuilder().Flavor())
+
+	rb := newRevokeBuilder()
+	rb.SetFlavor(PostgreSQL)
+	a.Equal(PostgreSQL, rb.Flavor())
+	a.Equal(ClickHouse, ClickHouse.NewRevokeBuilder().Flavor())
+}
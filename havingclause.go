@@ -0,0 +1,96 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+// HavingClause is a Builder for HAVING clause.
+// SelectBuilder has an anonymous `HavingClause` field, in which the conditions in HAVING are stored.
+//
+// Like `WhereClause`, HavingClause can be shared among multiple builders.
+// However, it is not thread-safe.
+type HavingClause struct {
+	flavor  Flavor
+	clauses []clause
+}
+
+var _ Builder = new(HavingClause)
+
+// NewHavingClause creates a new HavingClause.
+func NewHavingClause() *HavingClause {
+	return &HavingClause{}
+}
+
+// CopyHavingClause creates a copy of the havingClause.
+func CopyHavingClause(havingClause *HavingClause) *HavingClause {
+	clauses := make([]clause, len(havingClause.clauses))
+	copy(clauses, havingClause.clauses)
+
+	return &HavingClause{
+		flavor:  havingClause.flavor,
+		clauses: clauses,
+	}
+}
+
+// havingClauseProxy is a proxy for HavingClause.
+// It's useful when the HavingClause in a build can be changed.
+type havingClauseProxy struct {
+	*HavingClause
+}
+
+var _ Builder = new(havingClauseProxy)
+
+// BuildWithFlavor builds a HAVING clause with the specified flavor and initial arguments.
+func (hc *HavingClause) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	return buildClauses("HAVING ", hc.clauses, flavor, "", initialArg...)
+}
+
+// Build returns compiled HAVING clause string and args.
+func (hc *HavingClause) Build() (sql string, args []interface{}) {
+	return hc.BuildWithFlavor(hc.flavor)
+}
+
+// SetFlavor sets the flavor of compiled sql.
+// When the HavingClause belongs to a builder, the flavor of the builder will be used when building SQL.
+func (hc *HavingClause) SetFlavor(flavor Flavor) (old Flavor) {
+	old = hc.flavor
+	hc.flavor = flavor
+	return
+}
+
+// Flavor returns flavor of clause
+func (hc *HavingClause) Flavor() Flavor {
+	return hc.flavor
+}
+
+// AddHavingExpr adds an AND expression to HAVING clause with the specified arguments.
+func (hc *HavingClause) AddHavingExpr(args *Args, andExpr ...string) *HavingClause {
+	if len(andExpr) == 0 || estimateStringsBytes(andExpr) == 0 {
+		return hc
+	}
+
+	// Merge with last clause if possible.
+	if len(hc.clauses) > 0 {
+		lastClause := &hc.clauses[len(hc.clauses)-1]
+
+		if lastClause.args == args && !lastClause.or {
+			lastClause.andExprs = append(lastClause.andExprs, andExpr...)
+			return hc
+		}
+	}
+
+	hc.clauses = append(hc.clauses, clause{
+		args:     args,
+		andExprs: andExpr,
+	})
+	return hc
+}
+
+// AddHavingClause adds all clauses in the havingClause to the hc.
+func (hc *HavingClause) AddHavingClause(havingClause *HavingClause) *HavingClause {
+	if havingClause == nil {
+		return hc
+	}
+
+	hc.clauses = append(hc.clauses, havingClause.clauses...)
+	return hc
+}
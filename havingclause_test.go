@@ -0,0 +1,57 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleHavingClause() {
+	// Build a HAVING clause once and share it in a data query and a count query.
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("user_id", "SUM(amount)").From("orders").GroupBy("user_id")
+	sb.Having(sb.GreaterThan("SUM(amount)", 100))
+
+	countSB := PostgreSQL.NewSelectBuilder()
+	countSB.Select("user_id").From("orders")
+	countSB.Where(countSB.Equal("status", "paid"))
+	countSB.GroupBy("user_id")
+	countSB.HavingClause = sb.HavingClause
+
+	fmt.Println(sb.Build())
+	fmt.Println(countSB.Build())
+
+	// Output:
+	// SELECT user_id, SUM(amount) FROM orders GROUP BY user_id HAVING SUM(amount) > $1 [100]
+	// SELECT user_id FROM orders WHERE status = $1 GROUP BY user_id HAVING SUM(amount) > $2 [paid 100]
+}
+
+func TestCopyHavingClause(t *testing.T) {
+	a := assert.New(t)
+	sb := Select("a", "COUNT(*)").From("t").GroupBy("a")
+	sb.Having(sb.GreaterThan("COUNT(*)", 1), "")
+
+	hc := CopyHavingClause(sb.HavingClause)
+	sb.Having("MAX(b) < 10")
+
+	sb2 := Select("a").From("t2").GroupBy("a").AddHavingClause(hc)
+	sb2.Having(sb2.LessThan("MIN(b)", 0))
+
+	a.Equal(sb.String(), "SELECT a, COUNT(*) FROM t GROUP BY a HAVING COUNT(*) > ? AND MAX(b) < 10")
+	a.Equal(sb2.String(), "SELECT a FROM t2 GROUP BY a HAVING COUNT(*) > ? AND MIN(b) < ?")
+
+	sql, args := hc.Build()
+	a.Equal(sql, "HAVING COUNT(*) > ?")
+	a.Equal(args, []interface{}{1})
+
+	hc.SetFlavor(PostgreSQL)
+	a.Equal(hc.Flavor(), PostgreSQL)
+	sql, _ = hc.Build()
+	a.Equal(sql, "HAVING COUNT(*) > $1")
+
+	a.Equal(Select("a").From("t").GroupBy("a").Having().Having("").String(), "SELECT a FROM t GROUP BY a")
+}
@@ -17,12 +17,28 @@ func newInjection() *injection {
 	}
 }
 
+// clone returns a deep copy of injection.
+func (injection *injection) clone() *injection {
+	cloned := newInjection()
+
+	for marker, sqls := range injection.markerSQLs {
+		cloned.markerSQLs[marker] = append([]string(nil), sqls...)
+	}
+
+	return cloned
+}
+
 // SQL adds sql to injection's sql list.
 // All sqls inside injection is ordered by marker in ascending order.
 func (injection *injection) SQL(marker injectionMarker, sql string) {
 	injection.markerSQLs[marker] = append(injection.markerSQLs[marker], sql)
 }
 
+// clear removes all sqls at marker.
+func (injection *injection) clear(marker injectionMarker) {
+	delete(injection.markerSQLs, marker)
+}
+
 // WriteTo joins all SQL strings at the same marker value with blank (" ")
 // and writes the joined value to buf.
 func (injection *injection) WriteTo(buf *stringBuilder, marker injectionMarker) {
@@ -14,6 +14,8 @@ const (
 	insertMarkerAfterCols
 	insertMarkerAfterValues
 	insertMarkerAfterSelect
+	insertMarkerAfterOnConflict
+	insertMarkerAfterReturning
 )
 
 // NewInsertBuilder creates a new INSERT builder.
@@ -42,7 +44,23 @@ type InsertBuilder struct {
 	injection *injection
 	marker    injectionMarker
 
-	sbHolder string
+	sb         *SelectBuilder
+	sbHolder   string
+	onConflict *OnConflictClause
+	batchSize  int
+
+	defaultValues         bool
+	returning             []string
+	overridingSystemValue bool
+}
+
+// OnConflictClause is the ON CONFLICT clause of an INSERT for upsert.
+// It's created by `InsertBuilder#OnConflict`.
+type OnConflictClause struct {
+	ib          *InsertBuilder
+	cols        []string
+	doNothing   bool
+	assignments []string
 }
 
 var _ Builder = new(InsertBuilder)
@@ -95,10 +113,20 @@ func (ib *InsertBuilder) Cols(col ...string) *InsertBuilder {
 // Select returns a new SelectBuilder to build a SELECT statement inside the INSERT INTO.
 func (isb *InsertBuilder) Select(col ...string) *SelectBuilder {
 	sb := Select(col...)
+	isb.sb = sb
 	isb.sbHolder = isb.args.Add(sb)
 	return sb
 }
 
+// OverridingSystemValue adds OVERRIDING SYSTEM VALUE before VALUES or SELECT
+// to insert explicit values into identity columns defined as GENERATED ALWAYS.
+//
+// It's only written in PostgreSQL and omitted in other flavors.
+func (ib *InsertBuilder) OverridingSystemValue() *InsertBuilder {
+	ib.overridingSystemValue = true
+	return ib
+}
+
 // Values adds a list of values for a row in INSERT.
 func (ib *InsertBuilder) Values(value ...interface{}) *InsertBuilder {
 	placeholders := make([]string, 0, len(value))
@@ -112,6 +140,228 @@ func (ib *InsertBuilder) Values(value ...interface{}) *InsertBuilder {
 	return ib
 }
 
+// OnConflict sets conflict target columns for upsert and returns the ON CONFLICT clause.
+// Call `OnConflictClause#DoNothing` or `OnConflictClause#DoUpdateSet` to set the action.
+//
+// In PostgreSQL and SQLite, it's written as "ON CONFLICT (col...) DO NOTHING"
+// or "ON CONFLICT (col...) DO UPDATE SET assignment...".
+// In MySQL, DO UPDATE is written as "ON DUPLICATE KEY UPDATE assignment..."
+// and DO NOTHING turns the verb to "INSERT IGNORE".
+// The clause is ignored in other flavors.
+// If neither action is set, the clause is omitted as "ON CONFLICT (col...)" alone is invalid.
+// `BuildWithFlavorStrict` reports an omitted clause as an error wrapping ErrUnsupportedFlavor.
+func (ib *InsertBuilder) OnConflict(col ...string) *OnConflictClause {
+	ib.onConflict = &OnConflictClause{
+		ib:   ib,
+		cols: EscapeAll(col...),
+	}
+	ib.marker = insertMarkerAfterOnConflict
+	return ib.onConflict
+}
+
+// Excluded returns an expression referencing the value proposed for insertion in col.
+// It's "EXCLUDED.col" in PostgreSQL and SQLite and "VALUES(col)" in MySQL.
+// It's useful to build assignments in `OnConflictClause#DoUpdateSet`.
+func (ib *InsertBuilder) Excluded(col string) string {
+	return ib.excluded(Escape(col))
+}
+
+func (ib *InsertBuilder) excluded(col string) string {
+	return ib.args.Add(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			if ctx.Flavor.isMySQLCompatible() {
+				ctx.WriteString("VALUES(")
+				ctx.WriteString(col)
+				ctx.WriteString(")")
+				return
+			}
+
+			ctx.WriteString("EXCLUDED.")
+			ctx.WriteString(col)
+		},
+	})
+}
+
+// DoNothing sets the action of ON CONFLICT to DO NOTHING.
+func (oc *OnConflictClause) DoNothing() *InsertBuilder {
+	oc.doNothing = true
+	oc.assignments = nil
+	return oc.ib
+}
+
+// DoUpdateSet sets the action of ON CONFLICT to DO UPDATE SET assignment...
+// The assignment can be built with `InsertBuilder#Excluded`, e.g. "name = " + ib.Excluded("name").
+func (oc *OnConflictClause) DoUpdateSet(assignment ...string) *InsertBuilder {
+	oc.doNothing = false
+	oc.assignments = assignment
+	return oc.ib
+}
+
+func (oc *OnConflictClause) writeTo(buf *stringBuilder, flavor Flavor) {
+	if !oc.hasAction() {
+		return
+	}
+
+	switch flavor {
+	case PostgreSQL, SQLite, DuckDB:
+		buf.WriteLeadingString("ON CONFLICT")
+
+		if len(oc.cols) > 0 {
+			buf.WriteString(" (")
+			buf.WriteStrings(oc.cols, ", ")
+			buf.WriteString(")")
+		}
+
+		if oc.doNothing {
+			buf.WriteString(" DO NOTHING")
+		} else {
+			buf.WriteString(" DO UPDATE SET ")
+			buf.WriteStrings(oc.assignments, ", ")
+		}
+
+	case MySQL, MariaDB:
+		if !oc.doNothing {
+			buf.WriteLeadingString("ON DUPLICATE KEY UPDATE ")
+			buf.WriteStrings(oc.assignments, ", ")
+		}
+	}
+}
+
+// hasAction returns true if DO NOTHING or DO UPDATE SET is set.
+func (oc *OnConflictClause) hasAction() bool {
+	return oc.doNothing || len(oc.assignments) > 0
+}
+
+// DefaultValues inserts a row with default values in all columns.
+// Columns and values set by `Cols` and `Values` are ignored.
+//
+// It's written as "INSERT INTO t () VALUES ()" in MySQL
+// and "INSERT INTO t DEFAULT VALUES" in other flavors.
+func (ib *InsertBuilder) DefaultValues() *InsertBuilder {
+	ib.defaultValues = true
+	ib.marker = insertMarkerAfterValues
+	return ib
+}
+
+// Returning sets columns returned by INSERT.
+// Use "*" to return all columns, e.g. `Returning("*")`.
+//
+// It's written as "RETURNING col..." at the end of INSERT in PostgreSQL, SQLite, MariaDB and DuckDB,
+// and "OUTPUT INSERTED.col..." before VALUES in SQLServer.
+// It's ignored in other flavors.
+func (ib *InsertBuilder) Returning(col ...string) *InsertBuilder {
+	ib.returning = col
+	ib.marker = insertMarkerAfterReturning
+	return ib
+}
+
+// ReturningAs adds a column returned by INSERT with an alias, e.g. "RETURNING col AS alias".
+// Unlike `Returning`, it appends the column to existing returning columns.
+func (ib *InsertBuilder) ReturningAs(col, alias string) *InsertBuilder {
+	ib.returning = append(ib.returning, col+" AS "+alias)
+	ib.marker = insertMarkerAfterReturning
+	return ib
+}
+
+// ValuesRows adds a list of rows in INSERT.
+// It's the same as calling `Values` for every row.
+func (ib *InsertBuilder) ValuesRows(rows ...[]interface{}) *InsertBuilder {
+	for _, row := range rows {
+		ib.Values(row...)
+	}
+
+	return ib
+}
+
+// BatchSize sets the max number of rows in one INSERT statement built by `BuildBatches`.
+// If n is not positive, the max number of rows is decided by
+// the max number of placeholders in one statement supported by the flavor.
+// Args shared by all batches, e.g. initial args and args in ON CONFLICT, are subtracted from the max number,
+// and rows are counted by the max number of args in a row including args of nested builders.
+func (ib *InsertBuilder) BatchSize(n int) *InsertBuilder {
+	ib.batchSize = n
+	return ib
+}
+
+// BuildBatches splits rows into batches and returns compiled INSERT strings and args for every batch.
+// See doc in `BatchSize` for how many rows are in a batch.
+//
+// It's useful to insert a huge number of rows as databases limit the number of placeholders in one statement,
+// e.g. PostgreSQL accepts at most 65535 placeholders.
+// If ib inserts rows by a SELECT, only one batch is returned.
+func (ib *InsertBuilder) BuildBatches() (sqls []string, args [][]interface{}) {
+	return ib.BuildBatchesWithFlavor(ib.args.Flavor)
+}
+
+// BuildBatchesWithFlavor is the same as `BuildBatches` with flavor and initial args.
+func (ib *InsertBuilder) BuildBatchesWithFlavor(flavor Flavor, initialArg ...interface{}) (sqls []string, args [][]interface{}) {
+	size := ib.batchSize
+
+	if size <= 0 {
+		size = ib.maxRowsPerBatch(flavor, initialArg)
+	}
+
+	if ib.sbHolder != "" || size <= 0 || len(ib.values) <= size {
+		sql, values := ib.BuildWithFlavor(flavor, initialArg...)
+		return []string{sql}, [][]interface{}{values}
+	}
+
+	for start := 0; start < len(ib.values); start += size {
+		end := start + size
+
+		if end > len(ib.values) {
+			end = len(ib.values)
+		}
+
+		batch := *ib
+		batch.values = ib.values[start:end]
+		sql, values := batch.BuildWithFlavor(flavor, initialArg...)
+		sqls = append(sqls, sql)
+		args = append(args, values)
+	}
+
+	return
+}
+
+// maxRowsPerBatch returns the max number of rows in one statement according to placeholder limit in flavor.
+// Args not in rows, e.g. initialArg and args in ON CONFLICT and RETURNING, are shared by all batches
+// and are subtracted from the limit. It returns 0 if there is no limit.
+func (ib *InsertBuilder) maxRowsPerBatch(flavor Flavor, initialArg []interface{}) int {
+	var limit int
+
+	switch flavor {
+	case MySQL, MariaDB, PostgreSQL, Oracle:
+		limit = 65535
+	case SQLite:
+		limit = 32766
+	case SQLServer:
+		limit = 2100
+	default:
+		return 0
+	}
+
+	// Count args in a statement without rows.
+	empty := *ib
+	empty.values = nil
+	_, fixedArgs := empty.BuildWithFlavor(flavor, initialArg...)
+	limit -= len(fixedArgs)
+
+	// Count args in every row, including args of nested builders.
+	rowArgs := 1
+
+	for _, row := range ib.values {
+		if _, values := ib.args.CompileWithFlavor(strings.Join(row, ", "), flavor); len(values) > rowArgs {
+			rowArgs = len(values)
+		}
+	}
+
+	if limit < rowArgs {
+		return 1
+	}
+
+	return limit / rowArgs
+}
+
 // NumValue returns the number of values to insert.
 func (ib *InsertBuilder) NumValue() int {
 	return len(ib.values)
@@ -129,6 +379,88 @@ func (ib *InsertBuilder) Build() (sql string, args []interface{}) {
 	return ib.BuildWithFlavor(ib.args.Flavor)
 }
 
+// BuildE returns compiled INSERT string and args like `Build`.
+// Unlike `Build`, it returns an error if the INSERT is invalid, e.g. an expression built by
+// a Cond not sharing args with the builder is used. See `BuildWithFlavorStrict` for details.
+//
+// The error names the offending expression and its clause if it's found in the builder.
+func (ib *InsertBuilder) BuildE() (sql string, args []interface{}, err error) {
+	return ib.BuildWithFlavorStrict(ib.args.Flavor)
+}
+
+// BuildWithFlavorStrict works like `BuildWithFlavor` and validates the compiled INSERT.
+// It returns an error wrapping ErrInvalidArg if any placeholder cannot be resolved,
+// or an error wrapping ErrUnsupportedFlavor if any clause would be omitted in flavor.
+//
+// For INSERT ... SELECT, it returns an error wrapping ErrColumnCountMismatch
+// if the number of columns set by `Cols` differs from the number of columns in SELECT.
+// The check is skipped if SELECT contains "*".
+func (ib *InsertBuilder) BuildWithFlavorStrict(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}, err error) {
+	return buildStrict(ib, flavor, initialArg...)
+}
+
+func (ib *InsertBuilder) checkFlavor(flavor Flavor) error {
+	if len(ib.returning) > 0 {
+		switch flavor {
+		case PostgreSQL, SQLite, MariaDB, DuckDB, SQLServer:
+		default:
+			return unsupportedFlavorError("RETURNING", flavor)
+		}
+	}
+
+	if ib.onConflict != nil {
+		if !ib.onConflict.hasAction() {
+			return unsupportedFlavorError("ON CONFLICT without DO NOTHING or DO UPDATE", flavor)
+		}
+
+		switch flavor {
+		case PostgreSQL, SQLite, DuckDB:
+		case MySQL, MariaDB:
+			// DO NOTHING is written as "INSERT IGNORE", which cannot be combined with REPLACE.
+			if ib.onConflict.doNothing && ib.verb == "REPLACE" {
+				return unsupportedFlavorError("ON CONFLICT DO NOTHING in REPLACE", flavor)
+			}
+		default:
+			return unsupportedFlavorError("ON CONFLICT", flavor)
+		}
+	}
+
+	if ib.overridingSystemValue && flavor != PostgreSQL {
+		return unsupportedFlavorError("OVERRIDING SYSTEM VALUE", flavor)
+	}
+
+	return nil
+}
+
+func (ib *InsertBuilder) validate() error {
+	if ib.sb == nil || len(ib.cols) == 0 || ib.sb.NumCol() == 0 {
+		return nil
+	}
+
+	// The number of columns selected by "*" is unknown.
+	for _, col := range ib.sb.selectCols {
+		if col == "*" || strings.HasSuffix(col, ".*") {
+			return nil
+		}
+	}
+
+	if len(ib.cols) != ib.sb.NumCol() {
+		return fmt.Errorf("%w: %d columns in INSERT but %d columns in SELECT", ErrColumnCountMismatch, len(ib.cols), ib.sb.NumCol())
+	}
+
+	return nil
+}
+
+func (ib *InsertBuilder) listExprs() []exprGroup {
+	groups := make([]exprGroup, 0, len(ib.values))
+
+	for _, row := range ib.values {
+		groups = append(groups, exprGroup{clause: "VALUES", args: ib.args, exprs: row})
+	}
+
+	return groups
+}
+
 // BuildWithFlavor returns compiled INSERT string and args with flavor and initial args.
 // They can be used in `DB#Query` of package `database/sql` directly.
 func (ib *InsertBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
@@ -167,13 +499,34 @@ func (ib *InsertBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 	}
 
 	if len(ib.table) > 0 {
-		buf.WriteLeadingString(ib.verb)
+		verb := ib.verb
+
+		if flavor.isMySQLCompatible() && verb == "INSERT" && ib.onConflict != nil && ib.onConflict.doNothing {
+			verb = "INSERT IGNORE"
+		}
+
+		buf.WriteLeadingString(verb)
 		buf.WriteString(" INTO ")
 		buf.WriteString(ib.table)
 	}
 
 	ib.injection.WriteTo(buf, insertMarkerAfterInsertInto)
 
+	if ib.defaultValues {
+		ib.writeOutput(buf, flavor)
+		ib.writeOverriding(buf, flavor)
+
+		if flavor.isMySQLCompatible() {
+			buf.WriteLeadingString("() VALUES ()")
+		} else {
+			buf.WriteLeadingString("DEFAULT VALUES")
+		}
+
+		ib.injection.WriteTo(buf, insertMarkerAfterValues)
+		ib.writeSuffix(buf, flavor)
+		return ib.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+	}
+
 	if len(ib.cols) > 0 {
 		buf.WriteLeadingString("(")
 		buf.WriteStrings(ib.cols, ", ")
@@ -182,11 +535,15 @@ func (ib *InsertBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 		ib.injection.WriteTo(buf, insertMarkerAfterCols)
 	}
 
+	ib.writeOutput(buf, flavor)
+	ib.writeOverriding(buf, flavor)
+
 	if ib.sbHolder != "" {
 		buf.WriteString(" ")
 		buf.WriteString(ib.sbHolder)
 
 		ib.injection.WriteTo(buf, insertMarkerAfterSelect)
+		ib.writeSuffix(buf, flavor)
 		return ib.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
 	}
 
@@ -202,10 +559,52 @@ func (ib *InsertBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 	}
 
 	ib.injection.WriteTo(buf, insertMarkerAfterValues)
+	ib.writeSuffix(buf, flavor)
 
 	return ib.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
 }
 
+// writeOverriding writes OVERRIDING SYSTEM VALUE in PostgreSQL.
+func (ib *InsertBuilder) writeOverriding(buf *stringBuilder, flavor Flavor) {
+	if ib.overridingSystemValue && flavor == PostgreSQL {
+		buf.WriteLeadingString("OVERRIDING SYSTEM VALUE")
+	}
+}
+
+// writeOutput writes the OUTPUT clause in SQLServer.
+func (ib *InsertBuilder) writeOutput(buf *stringBuilder, flavor Flavor) {
+	if flavor != SQLServer || len(ib.returning) == 0 {
+		return
+	}
+
+	buf.WriteLeadingString("OUTPUT ")
+
+	for i, col := range ib.returning {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+
+		buf.WriteString("INSERTED.")
+		buf.WriteString(col)
+	}
+
+	ib.injection.WriteTo(buf, insertMarkerAfterReturning)
+}
+
+// writeSuffix writes ON CONFLICT and RETURNING after values.
+func (ib *InsertBuilder) writeSuffix(buf *stringBuilder, flavor Flavor) {
+	if ib.onConflict != nil {
+		ib.onConflict.writeTo(buf, flavor)
+		ib.injection.WriteTo(buf, insertMarkerAfterOnConflict)
+	}
+
+	if len(ib.returning) > 0 && (flavor == PostgreSQL || flavor == SQLite || flavor == MariaDB || flavor == DuckDB) {
+		buf.WriteLeadingString("RETURNING ")
+		buf.WriteStrings(ib.returning, ", ")
+		ib.injection.WriteTo(buf, insertMarkerAfterReturning)
+	}
+}
+
 // SetFlavor sets the flavor of compiled sql.
 func (ib *InsertBuilder) SetFlavor(flavor Flavor) (old Flavor) {
 	old = ib.args.Flavor
@@ -228,3 +627,9 @@ func (ib *InsertBuilder) SQL(sql string) *InsertBuilder {
 	ib.injection.SQL(ib.marker, sql)
 	return ib
 }
+
+// SQLf adds an arbitrary sql built by `Build` with format and args to current position.
+// It's a shortcut of `ib.SQL(ib.Var(Build(format, args...)))`.
+func (ib *InsertBuilder) SQLf(format string, args ...interface{}) *InsertBuilder {
+	return ib.SQL(ib.Var(Build(format, args...)))
+}
@@ -4,6 +4,7 @@
 package sqlbuilder
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -257,3 +258,224 @@ func TestInsertBuilderGetFlavor(t *testing.T) {
 	flavor = ibClick.Flavor()
 	a.Equal(ClickHouse, flavor)
 }
+
+func ExampleInsertBuilder_OnConflict() {
+	ib := NewInsertBuilder()
+	ib.InsertInto("users")
+	ib.Cols("id", "name", "visits")
+	ib.Values(1, "Huan", 1)
+	ib.OnConflict("id").DoUpdateSet(
+		"name = "+ib.Excluded("name"),
+		"visits = users.visits + 1",
+	)
+
+	fmt.Println(ib.BuildWithFlavor(PostgreSQL))
+	fmt.Println(ib.BuildWithFlavor(MySQL))
+
+	// Output:
+	// INSERT INTO users (id, name, visits) VALUES ($1, $2, $3) ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name, visits = users.visits + 1 [1 Huan 1]
+	// INSERT INTO users (id, name, visits) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE name = VALUES(name), visits = users.visits + 1 [1 Huan 1]
+}
+
+func TestInsertBuilderOnConflictDoNothing(t *testing.T) {
+	a := assert.New(t)
+	ib := InsertInto("users").Cols("id").Values(1)
+	ib.OnConflict().DoNothing()
+	ib.SQL("/* after on conflict */")
+
+	cases := map[Flavor]string{
+		PostgreSQL: "INSERT INTO users (id) VALUES ($1) ON CONFLICT DO NOTHING /* after on conflict */",
+		SQLite:     "INSERT INTO users (id) VALUES (?) ON CONFLICT DO NOTHING /* after on conflict */",
+		MySQL:      "INSERT IGNORE INTO users (id) VALUES (?) /* after on conflict */",
+		SQLServer:  "INSERT INTO users (id) VALUES (@p1) /* after on conflict */",
+	}
+
+	for flavor, expected := range cases {
+		sql, _ := ib.BuildWithFlavor(flavor)
+		a.Equal(sql, expected)
+	}
+
+	ib = InsertInto("users").Cols("id", "name")
+	ib.Select("id", "name").From("old_users")
+	ib.OnConflict("id").DoNothing()
+	sql, _ := ib.BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "INSERT INTO users (id, name) SELECT id, name FROM old_users ON CONFLICT (id) DO NOTHING")
+
+	// ON CONFLICT without an action is omitted.
+	ib = InsertInto("users").Cols("id").Values(1)
+	ib.OnConflict("id")
+	sql, _ = ib.BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "INSERT INTO users (id) VALUES ($1)")
+
+	_, _, err := ib.BuildWithFlavorStrict(PostgreSQL)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+	_, _, err = ib.BuildWithFlavorStrict(MySQL)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+}
+
+func ExampleInsertBuilder_BuildBatches() {
+	ib := NewInsertBuilder()
+	ib.InsertInto("users").Cols("id", "name")
+	ib.ValuesRows(
+		[]interface{}{1, "a"},
+		[]interface{}{2, "b"},
+		[]interface{}{3, "c"},
+	)
+	ib.BatchSize(2)
+
+	sqls, args := ib.BuildBatchesWithFlavor(PostgreSQL)
+
+	for i := range sqls {
+		fmt.Println(sqls[i], args[i])
+	}
+
+	// Output:
+	// INSERT INTO users (id, name) VALUES ($1, $2), ($3, $4) [1 a 2 b]
+	// INSERT INTO users (id, name) VALUES ($1, $2) [3 c]
+}
+
+func TestInsertBuilderBatchesByFlavor(t *testing.T) {
+	a := assert.New(t)
+	ib := InsertInto("t").Cols("a", "b", "c")
+
+	for i := 0; i < 1500; i++ {
+		ib.Values(i, i, i)
+	}
+
+	sqls, args := ib.BuildBatchesWithFlavor(SQLServer)
+	a.Equal(len(sqls), 3)
+	a.Equal(len(args[0]), 2100)
+	a.Equal(len(args[1]), 2100)
+	a.Equal(len(args[2]), 300)
+	a.Equal(args[2][0], 1400)
+
+	sqls, _ = ib.BuildBatchesWithFlavor(PostgreSQL)
+	a.Equal(len(sqls), 1)
+
+	sqls, _ = ib.BuildBatchesWithFlavor(ClickHouse)
+	a.Equal(len(sqls), 1)
+	a.Equal(ib.NumValue(), 1500)
+
+	// Args out of rows and in nested builders are counted.
+	ib = InsertInto("t").Cols("a", "b")
+
+	for i := 0; i < 1500; i++ {
+		sb := Select("id").From("u")
+		ib.Values(i, sb.Where(sb.Equal("x", i)))
+	}
+
+	sqls, args = ib.BuildBatchesWithFlavor(SQLServer, "init")
+	a.Equal(len(sqls), 2)
+	a.Equal(len(args[0]), 1+1049*2)
+	a.Equal(len(args[1]), 1+451*2)
+
+	for _, values := range args {
+		a.Assert(len(values) <= 2100)
+	}
+}
+
+func ExampleInsertBuilder_DefaultValues() {
+	ib := NewInsertBuilder()
+	ib.InsertInto("events").DefaultValues()
+
+	fmt.Println(ib.BuildWithFlavor(PostgreSQL))
+	fmt.Println(ib.BuildWithFlavor(MySQL))
+
+	// Output:
+	// INSERT INTO events DEFAULT VALUES []
+	// INSERT INTO events () VALUES () []
+}
+
+func TestInsertBuilderWithoutValues(t *testing.T) {
+	a := assert.New(t)
+	a.Equal(InsertInto("t").String(), "INSERT INTO t")
+	a.Equal(InsertInto("t").Cols("a").String(), "INSERT INTO t (a)")
+}
+
+func ExampleInsertBuilder_Returning() {
+	ib := NewInsertBuilder()
+	ib.InsertInto("users").Cols("name").Values("Huan")
+	ib.Returning("id", "created_at")
+
+	fmt.Println(ib.BuildWithFlavor(PostgreSQL))
+	fmt.Println(ib.BuildWithFlavor(SQLServer))
+
+	// Output:
+	// INSERT INTO users (name) VALUES ($1) RETURNING id, created_at [Huan]
+	// INSERT INTO users (name) OUTPUT INSERTED.id, INSERTED.created_at VALUES (@p1) [Huan]
+}
+
+func TestInsertBuilderReturning(t *testing.T) {
+	a := assert.New(t)
+	ib := InsertInto("users").DefaultValues().Returning("id")
+
+	sql, _ := ib.BuildWithFlavor(SQLServer)
+	a.Equal(sql, "INSERT INTO users OUTPUT INSERTED.id DEFAULT VALUES")
+
+	sql, _ = ib.BuildWithFlavor(MySQL)
+	a.Equal(sql, "INSERT INTO users () VALUES ()")
+
+	ib = InsertInto("users").Cols("id").Values(1).Returning("id")
+	ib.OnConflict("id").DoNothing()
+	sql, _ = ib.BuildWithFlavor(SQLite)
+	a.Equal(sql, "INSERT INTO users (id) VALUES (?) ON CONFLICT (id) DO NOTHING RETURNING id")
+
+	ib = InsertInto("users").Cols("name").Values("Huan").Returning("*")
+	sql, _ = ib.BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "INSERT INTO users (name) VALUES ($1) RETURNING *")
+	sql, _ = ib.BuildWithFlavor(SQLServer)
+	a.Equal(sql, "INSERT INTO users (name) OUTPUT INSERTED.* VALUES (@p1)")
+}
+
+func ExampleInsertBuilder_ReturningAs() {
+	ib := NewInsertBuilder()
+	ib.InsertInto("users").Cols("name").Values("Huan")
+	ib.ReturningAs("id", "new_id").ReturningAs("created_at", "ctime")
+
+	fmt.Println(ib.BuildWithFlavor(PostgreSQL))
+	fmt.Println(ib.BuildWithFlavor(SQLServer))
+
+	// Output:
+	// INSERT INTO users (name) VALUES ($1) RETURNING id AS new_id, created_at AS ctime [Huan]
+	// INSERT INTO users (name) OUTPUT INSERTED.id AS new_id, INSERTED.created_at AS ctime VALUES (@p1) [Huan]
+}
+
+func TestInsertBuilderSQLf(t *testing.T) {
+	a := assert.New(t)
+	ib := PostgreSQL.NewInsertBuilder()
+	ib.InsertInto("t").Cols("id", "n").Values(1, 2)
+	ib.SQLf("ON CONFLICT (id) DO UPDATE SET n = t.n + $?", 3)
+
+	sql, args := ib.Build()
+	a.Equal(sql, "INSERT INTO t (id, n) VALUES ($1, $2) ON CONFLICT (id) DO UPDATE SET n = t.n + $3")
+	a.Equal(args, []interface{}{1, 2, 3})
+}
+
+func ExampleInsertBuilder_OverridingSystemValue() {
+	ib := PostgreSQL.NewInsertBuilder()
+	ib.InsertInto("users").Cols("id", "name").Values(1, "Huan")
+	ib.OverridingSystemValue()
+
+	sql, args := ib.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// INSERT INTO users (id, name) OVERRIDING SYSTEM VALUE VALUES ($1, $2)
+	// [1 Huan]
+}
+
+func TestInsertBuilderOverridingSystemValue(t *testing.T) {
+	a := assert.New(t)
+	ib := InsertInto("users").Cols("id", "name").OverridingSystemValue()
+	ib.Select("id", "name").From("old_users")
+
+	sql, _ := ib.BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "INSERT INTO users (id, name) OVERRIDING SYSTEM VALUE SELECT id, name FROM old_users")
+	sql, _ = ib.BuildWithFlavor(MySQL)
+	a.Equal(sql, "INSERT INTO users (id, name) SELECT id, name FROM old_users")
+
+	ib = InsertInto("users").DefaultValues().OverridingSystemValue()
+	sql, _ = ib.BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "INSERT INTO users OVERRIDING SYSTEM VALUE DEFAULT VALUES")
+}
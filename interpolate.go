@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 	"unicode"
 	"unicode/utf8"
@@ -22,13 +23,32 @@ func mysqlInterpolate(query string, args ...interface{}) (string, error) {
 }
 
 func mysqlLikeInterpolate(flavor Flavor, query string, args ...interface{}) (string, error) {
+	max := len(args)
+
 	// Roughly estimate the size to avoid useless memory allocation and copy.
-	buf := make([]byte, 0, len(query)+len(args)*20)
+	return replaceQuestionMarks(query, len(query)+len(args)*20, func(buf []byte, cnt int) ([]byte, error) {
+		if cnt >= max {
+			return nil, ErrInterpolateMissingArgs
+		}
+
+		buf, err := encodeValue(buf, args[cnt], flavor)
+
+		if err != nil {
+			return nil, indexEncodeError(err, cnt, args[cnt])
+		}
+
+		return buf, nil
+	})
+}
+
+// replaceQuestionMarks parses query and calls replace for every "?" which is not quoted.
+// The cnt is the 0-based index of the "?" in query.
+func replaceQuestionMarks(query string, size int, replace func(buf []byte, cnt int) ([]byte, error)) (string, error) {
+	buf := make([]byte, 0, size)
 
 	var quote rune
 	var err error
 	cnt := 0
-	max := len(args)
 	escaping := false
 	offset := 0
 	target := query
@@ -49,12 +69,8 @@ func mysqlLikeInterpolate(flavor Flavor, query string, args ...interface{}) (str
 				continue
 			}
 
-			if cnt >= max {
-				return "", ErrInterpolateMissingArgs
-			}
-
 			buf = append(buf, query[:offset-sz]...)
-			buf, err = encodeValue(buf, args[cnt], flavor)
+			buf, err = replace(buf, cnt)
 
 			if err != nil {
 				return "", err
@@ -105,6 +121,33 @@ func mysqlLikeInterpolate(flavor Flavor, query string, args ...interface{}) (str
 	return *(*string)(unsafe.Pointer(&buf)), nil
 }
 
+// Rebind rewrites all "?" placeholders in query to the placeholder style of flavor,
+// e.g. "$1" in PostgreSQL, "@p1" in SQLServer and ":1" in Oracle.
+// The "?" in string literals and quoted identifiers is not touched.
+//
+// If flavor uses "?" as placeholder, query is returned as it is.
+func Rebind(flavor Flavor, query string) string {
+	var prefix string
+
+	switch flavor {
+	case PostgreSQL:
+		prefix = "$"
+	case SQLServer:
+		prefix = "@p"
+	case Oracle:
+		prefix = ":"
+	default:
+		return query
+	}
+
+	// Error is impossible as replace never fails.
+	rebound, _ := replaceQuestionMarks(query, len(query)+8, func(buf []byte, cnt int) ([]byte, error) {
+		buf = append(buf, prefix...)
+		return strconv.AppendInt(buf, int64(cnt+1), 10), nil
+	})
+	return rebound
+}
+
 // postgresqlInterpolate parses query and replace all "$*" with encoded args.
 // If there are more "$*" than len(args), returns ErrMissingArgs.
 // Otherwise, if there are less "$*" than len(args), the redundant args are omitted.
@@ -196,7 +239,7 @@ func postgresqlInterpolate(query string, args ...interface{}) (string, error) {
 				buf, err = encodeValue(buf, args[idx-1], PostgreSQL)
 
 				if err != nil {
-					return "", err
+					return "", indexEncodeError(err, int(idx-1), args[idx-1])
 				}
 
 				query = target
@@ -337,7 +380,7 @@ func sqlserverInterpolate(query string, args ...interface{}) (string, error) {
 				buf, err = encodeValue(buf, args[idx-1], SQLServer)
 
 				if err != nil {
-					return "", err
+					return "", indexEncodeError(err, int(idx-1), args[idx-1])
 				}
 
 				query = target
@@ -381,6 +424,16 @@ func sqlserverInterpolate(query string, args ...interface{}) (string, error) {
 	return *(*string)(unsafe.Pointer(&buf)), nil
 }
 
+// mariadbInterpolate works the same as MySQL interpolating.
+func mariadbInterpolate(query string, args ...interface{}) (string, error) {
+	return mysqlLikeInterpolate(MariaDB, query, args...)
+}
+
+// duckdbInterpolate works the same as ANSI interpolating except that bytes are written as a BLOB literal.
+func duckdbInterpolate(query string, args ...interface{}) (string, error) {
+	return mysqlLikeInterpolate(DuckDB, query, args...)
+}
+
 // mysqlInterpolate works the same as MySQL interpolating.
 func sqliteInterpolate(query string, args ...interface{}) (string, error) {
 	return mysqlLikeInterpolate(SQLite, query, args...)
@@ -403,6 +456,13 @@ func informixInterpolate(query string, args ...interface{}) (string, error) {
 	return mysqlLikeInterpolate(Informix, query, args...)
 }
 
+// ansiInterpolate parses query and replace all "?" with encoded args.
+// If there are more "?" than len(args), returns ErrMissingArgs.
+// Otherwise, if there are less "?" than len(args), the redundant args are omitted.
+func ansiInterpolate(query string, args ...interface{}) (string, error) {
+	return mysqlLikeInterpolate(ANSI, query, args...)
+}
+
 // oraclelInterpolate parses query and replace all ":*" with encoded args.
 // If there are more ":*" than len(args), returns ErrMissingArgs.
 // Otherwise, if there are less ":*" than len(args), the redundant args are omitted.
@@ -494,7 +554,7 @@ func oracleInterpolate(query string, args ...interface{}) (string, error) {
 				buf, err = encodeValue(buf, args[idx-1], Oracle)
 
 				if err != nil {
-					return "", err
+					return "", indexEncodeError(err, int(idx-1), args[idx-1])
 				}
 
 				query = target
@@ -567,18 +627,56 @@ func oracleInterpolate(query string, args ...interface{}) (string, error) {
 	return *(*string)(unsafe.Pointer(&buf)), nil
 }
 
+// indexEncodeError adds the index and type of the arg to err returned by encodeValue,
+// so that it's easy to find out which arg is not supported.
+// The returned error can be checked by `errors.Is(err, ErrInterpolateUnsupportedArgs)`.
+func indexEncodeError(err error, idx int, arg interface{}) error {
+	if err != ErrInterpolateUnsupportedArgs {
+		return err
+	}
+
+	return fmt.Errorf("%w: args[%d] is %T", err, idx, arg)
+}
+
+// encodeValue appends the SQL literal of arg to buf.
+//
+// Pointers are dereferenced and `driver.Valuer` values, including sql.Null* types, are unwrapped.
+// A nil pointer or an invalid sql.Null* value is written as NULL.
+// All integer and float kinds, including uintptr, are written as numeric literals.
+// Types which cannot be represented in SQL, e.g. complex, func, chan, map and struct
+// without `driver.Valuer` or `fmt.Stringer`, are rejected with ErrInterpolateUnsupportedArgs.
 func encodeValue(buf []byte, arg interface{}, flavor Flavor) ([]byte, error) {
 	switch v := arg.(type) {
 	case nil:
 		buf = append(buf, "NULL"...)
 
+	case interpolatedLiteral:
+		buf = append(buf, v...)
+
 	case driver.Valuer:
+		// A nil pointer is NULL, even if its Value method has a pointer receiver.
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+			buf = append(buf, "NULL"...)
+			break
+		}
+
+		// The returned value is encoded recursively,
+		// so that a Valuer returning []byte, time.Time, int64, etc. is formatted per flavor.
 		if val, err := v.Value(); err != nil {
 			return nil, err
 		} else {
 			return encodeValue(buf, val, flavor)
 		}
 
+	case *time.Time:
+		// *time.Time is a fmt.Stringer. Dereference it to write a time literal.
+		if v == nil {
+			buf = append(buf, "NULL"...)
+			break
+		}
+
+		return encodeValue(buf, *v, flavor)
+
 	case time.Time:
 		if v.IsZero() {
 			buf = append(buf, "'0000-00-00'"...)
@@ -590,7 +688,7 @@ func encodeValue(buf []byte, arg interface{}, flavor Flavor) ([]byte, error) {
 		v = v.Add(500 * time.Nanosecond)
 
 		switch flavor {
-		case MySQL:
+		case MySQL, MariaDB:
 			buf = append(buf, v.Format("'2006-01-02 15:04:05.999999'")...)
 
 		case PostgreSQL:
@@ -619,6 +717,8 @@ func encodeValue(buf []byte, arg interface{}, flavor Flavor) ([]byte, error) {
 		case Informix:
 			buf = append(buf, v.Format("'2006-01-02 15:04:05.999999'")...)
 
+		case ANSI, DuckDB:
+			buf = append(buf, v.Format("TIMESTAMP '2006-01-02 15:04:05.999999'")...)
 		}
 
 	case fmt.Stringer:
@@ -674,6 +774,9 @@ func encodeValue(buf []byte, arg interface{}, flavor Flavor) ([]byte, error) {
 		case reflect.Uint64:
 			buf = strconv.AppendUint(buf, primative.Uint(), 10)
 
+		case reflect.Uintptr:
+			buf = strconv.AppendUint(buf, primative.Uint(), 10)
+
 		case reflect.Float32:
 			buf = strconv.AppendFloat(buf, primative.Float(), 'g', -1, 32)
 
@@ -683,6 +786,15 @@ func encodeValue(buf []byte, arg interface{}, flavor Flavor) ([]byte, error) {
 		case reflect.String:
 			buf = quoteStringValue(buf, primative.String(), flavor)
 
+		case reflect.Ptr:
+			// Pointers, e.g. *string, are dereferenced. A nil pointer is NULL.
+			if primative.IsNil() {
+				buf = append(buf, "NULL"...)
+				break
+			}
+
+			return encodeValue(buf, primative.Elem().Interface(), flavor)
+
 		case reflect.Slice, reflect.Array:
 			if k == reflect.Slice && primative.IsNil() {
 				buf = append(buf, "NULL"...)
@@ -709,7 +821,7 @@ func encodeValue(buf []byte, arg interface{}, flavor Flavor) ([]byte, error) {
 			}
 
 			switch flavor {
-			case MySQL:
+			case MySQL, MariaDB:
 				buf = append(buf, "_binary"...)
 				buf = quoteStringValue(buf, *(*string)(unsafe.Pointer(&data)), flavor)
 
@@ -718,7 +830,7 @@ func encodeValue(buf []byte, arg interface{}, flavor Flavor) ([]byte, error) {
 				buf = appendHex(buf, data)
 				buf = append(buf, "'::bytea"...)
 
-			case SQLite:
+			case SQLite, ANSI:
 				buf = append(buf, "X'"...)
 				buf = appendHex(buf, data)
 				buf = append(buf, '\'')
@@ -741,6 +853,15 @@ func encodeValue(buf []byte, arg interface{}, flavor Flavor) ([]byte, error) {
 				buf = append(buf, "hextoraw('"...)
 				buf = appendHex(buf, data)
 				buf = append(buf, "')"...)
+
+			case DuckDB:
+				buf = append(buf, '\'')
+
+				for _, b := range data {
+					buf = append(buf, '\\', 'x', hexDigits[(b>>4)&0xF], hexDigits[b&0xF])
+				}
+
+				buf = append(buf, "'::BLOB"...)
 			default:
 				return nil, ErrInterpolateUnsupportedArgs
 			}
@@ -753,6 +874,80 @@ func encodeValue(buf []byte, arg interface{}, flavor Flavor) ([]byte, error) {
 	return buf, nil
 }
 
+// interpolatedLiteral is a literal which is written as it is in interpolation.
+type interpolatedLiteral string
+
+// truncateArgs returns a copy of args in which string and byte slice values
+// longer than maxLen bytes are replaced by truncated literals.
+func truncateArgs(flavor Flavor, args []interface{}, maxLen int) ([]interface{}, error) {
+	truncated := make([]interface{}, len(args))
+
+	for i, arg := range args {
+		v, err := truncateValue(flavor, arg, maxLen)
+
+		if err != nil {
+			return nil, indexEncodeError(err, i, arg)
+		}
+
+		truncated[i] = v
+	}
+
+	return truncated, nil
+}
+
+func truncateValue(flavor Flavor, arg interface{}, maxLen int) (interface{}, error) {
+	if valuer, ok := arg.(driver.Valuer); ok {
+		// A nil pointer is encoded as NULL by encodeValue without calling its Value method.
+		if rv := reflect.ValueOf(valuer); rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return arg, nil
+		}
+
+		val, err := valuer.Value()
+
+		if err != nil {
+			return nil, err
+		}
+
+		arg = val
+	}
+
+	var marker string
+
+	switch v := arg.(type) {
+	case string:
+		if len(v) <= maxLen {
+			return arg, nil
+		}
+
+		marker = "...(" + strconv.Itoa(len(v)) + " bytes)"
+
+		// Truncate at rune boundary.
+		n := maxLen
+
+		for n > 0 && !utf8.RuneStart(v[n]) {
+			n--
+		}
+
+		return v[:n] + marker, nil
+
+	case []byte:
+		if len(v) <= maxLen {
+			return arg, nil
+		}
+
+		marker = " /* ...(" + strconv.Itoa(len(v)) + " bytes) */"
+		buf, err := encodeValue(nil, v[:maxLen], flavor)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return interpolatedLiteral(append(buf, marker...)), nil
+	}
+
+	return arg, nil
+}
+
 var hexDigits = [16]byte{'0', '1', '2', '3', '4', '5', '6', '7', '8', '9', 'A', 'B', 'C', 'D', 'E', 'F'}
 
 func appendHex(buf, v []byte) []byte {
@@ -772,6 +967,21 @@ func quoteStringValue(buf []byte, s string, flavor Flavor) []byte {
 	}
 
 	buf = append(buf, '\'')
+
+	// ANSI SQL and DuckDB don't support backslash escape sequences.
+	// The only character to escape is single quote.
+	if flavor == ANSI || flavor == DuckDB {
+		for i := strings.IndexByte(s, '\''); i >= 0; i = strings.IndexByte(s, '\'') {
+			buf = append(buf, s[:i+1]...)
+			buf = append(buf, '\'')
+			s = s[i+1:]
+		}
+
+		buf = append(buf, s...)
+		buf = append(buf, '\'')
+		return buf
+	}
+
 	r, sz := utf8.DecodeRuneInString(s)
 
 	for ; sz != 0; r, sz = utf8.DecodeRuneInString(s) {
@@ -1,10 +1,12 @@
 package sqlbuilder
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,6 +21,20 @@ func (v errorValuer) Value() (driver.Value, error) {
 	return 0, ErrErrorValuer
 }
 
+type bytesValuer string
+
+func (v bytesValuer) Value() (driver.Value, error) {
+	return []byte(v), nil
+}
+
+type timeValuer struct {
+	t time.Time
+}
+
+func (v *timeValuer) Value() (driver.Value, error) {
+	return v.t, nil
+}
+
 func TestFlavorInterpolate(t *testing.T) {
 	dt := time.Date(2019, 4, 24, 12, 23, 34, 123456789, time.FixedZone("CST", 8*60*60)) // 2019-04-24 12:23:34.987654321 CST
 	_, errOutOfRange := strconv.ParseInt("12345678901234567890", 10, 32)
@@ -60,6 +76,11 @@ func TestFlavorInterpolate(t *testing.T) {
 			"SELECT ?", nil,
 			"", ErrInterpolateMissingArgs,
 		},
+		{
+			MySQL,
+			"SELECT ?", []interface{}{uintptr(1234)},
+			"SELECT 1234", nil,
+		},
 		{
 			MySQL,
 			"SELECT ?", []interface{}{complex(1, 2)},
@@ -371,6 +392,36 @@ func TestFlavorInterpolate(t *testing.T) {
 			"SELECT ?", []interface{}{errorValuer(1)},
 			"", ErrErrorValuer,
 		},
+		{
+			ANSI,
+			"SELECT * FROM a WHERE name = ? AND state IN (?, ?, ?, ?, ?)", []interface{}{"I'm fine", 42, int8(8), int16(-16), int32(32), int64(64)},
+			"SELECT * FROM a WHERE name = 'I''m fine' AND state IN (42, 8, -16, 32, 64)", nil,
+		},
+		{
+			ANSI,
+			"SELECT * FROM \"a?\" WHERE name = '?' AND state IN (?, ?)", []interface{}{"back\\slash", uint(42)},
+			"SELECT * FROM \"a?\" WHERE name = '?' AND state IN ('back\\slash', 42)", nil,
+		},
+		{
+			ANSI,
+			"SELECT ?, ?, ?, ?, ?, ?, ?, ?", []interface{}{true, false, float32(1.234567), float64(9.87654321), []byte(nil), []byte("I'm bytes"), dt, nil},
+			"SELECT TRUE, FALSE, 1.234567, 9.87654321, NULL, X'49276D206279746573', TIMESTAMP '2019-04-24 12:23:34.123457', NULL", nil,
+		},
+		{
+			ANSI,
+			"SELECT ?", nil,
+			"", ErrInterpolateMissingArgs,
+		},
+		{
+			MariaDB,
+			"SELECT * FROM a WHERE name = ? AND data = ? AND created_at = ?", []interface{}{"I'm fine", []byte("bytes"), dt},
+			"SELECT * FROM a WHERE name = 'I\\'m fine' AND data = _binary'bytes' AND created_at = '2019-04-24 12:23:34.123457'", nil,
+		},
+		{
+			DuckDB,
+			"SELECT * FROM a WHERE name = ? AND data = ? AND created_at = ? AND ok = ?", []interface{}{"I'm \\fine", []byte("by"), dt, true},
+			"SELECT * FROM a WHERE name = 'I''m \\fine' AND data = '\\x62\\x79'::BLOB AND created_at = TIMESTAMP '2019-04-24 12:23:34.123457' AND ok = TRUE", nil,
+		},
 	}
 
 	for idx, c := range cases {
@@ -380,7 +431,109 @@ func TestFlavorInterpolate(t *testing.T) {
 			query, err := c.Flavor.Interpolate(c.SQL, c.Args)
 
 			a.Equal(query, c.Query)
-			a.Assert(err == c.Err || err.Error() == c.Err.Error())
+			a.Assert(err == c.Err || errors.Is(err, c.Err) || err.Error() == c.Err.Error())
 		})
 	}
 }
+
+func TestFlavorInterpolateValuer(t *testing.T) {
+	a := assert.New(t)
+	dt := time.Date(2019, 4, 24, 12, 23, 34, 123456789, time.FixedZone("CST", 8*60*60))
+	var nilValuer *timeValuer
+
+	query, err := MySQL.Interpolate("SELECT ?, ?, ?", []interface{}{bytesValuer("I'm bytes"), &timeValuer{dt}, nilValuer})
+	a.NilError(err)
+	a.Equal(query, "SELECT _binary'I\\'m bytes', '2019-04-24 12:23:34.123457', NULL")
+
+	query, err = PostgreSQL.Interpolate("SELECT $1, $2", []interface{}{bytesValuer("I'm bytes"), &timeValuer{dt}})
+	a.NilError(err)
+	a.Equal(query, "SELECT E'\\\\x49276D206279746573'::bytea, '2019-04-24 12:23:34.123457 CST'")
+
+	query, err = SQLite.Interpolate("SELECT ?", []interface{}{bytesValuer("hi")})
+	a.NilError(err)
+	a.Equal(query, "SELECT X'6869'")
+}
+
+func TestFlavorInterpolateNullable(t *testing.T) {
+	a := assert.New(t)
+	dt := time.Date(2019, 4, 24, 12, 23, 34, 123456789, time.FixedZone("CST", 8*60*60))
+	str := "foo"
+	var nilStr *string
+	n := 42
+	pn := &n
+
+	args := []interface{}{
+		&str, nilStr, &pn,
+		sql.NullString{String: "bar", Valid: true}, sql.NullString{},
+		sql.NullInt64{Int64: 64, Valid: true}, sql.NullInt64{},
+		sql.NullTime{Time: dt, Valid: true}, sql.NullTime{},
+		&dt,
+	}
+
+	query, err := MySQL.Interpolate("SELECT ?, ?, ?, ?, ?, ?, ?, ?, ?, ?", args)
+	a.NilError(err)
+	a.Equal(query, "SELECT 'foo', NULL, 42, 'bar', NULL, 64, NULL, '2019-04-24 12:23:34.123457', NULL, '2019-04-24 12:23:34.123457'")
+
+	query, err = PostgreSQL.Interpolate("SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9, $10", args)
+	a.NilError(err)
+	a.Equal(query, "SELECT E'foo', NULL, 42, E'bar', NULL, 64, NULL, '2019-04-24 12:23:34.123457 CST', NULL, '2019-04-24 12:23:34.123457 CST'")
+}
+
+func TestFlavorInterpolateUnsupportedArgs(t *testing.T) {
+	a := assert.New(t)
+	cases := []struct {
+		Flavor Flavor
+		SQL    string
+		Args   []interface{}
+		ErrMsg string
+	}{
+		{MySQL, "SELECT ?, ?", []interface{}{1, complex64(1)}, "go-sqlbuilder: unsupported args when interpolating: args[1] is complex64"},
+		{PostgreSQL, "SELECT $2, $1", []interface{}{complex(1, 2), 1}, "go-sqlbuilder: unsupported args when interpolating: args[0] is complex128"},
+		{SQLServer, "SELECT @p1", []interface{}{func() {}}, "go-sqlbuilder: unsupported args when interpolating: args[0] is func()"},
+		{Oracle, "SELECT :1", []interface{}{make(chan int)}, "go-sqlbuilder: unsupported args when interpolating: args[0] is chan int"},
+	}
+
+	for _, c := range cases {
+		_, err := c.Flavor.Interpolate(c.SQL, c.Args)
+		a.Assert(errors.Is(err, ErrInterpolateUnsupportedArgs))
+		a.Equal(err.Error(), c.ErrMsg)
+	}
+}
+
+func TestFlavorInterpolateTruncated(t *testing.T) {
+	a := assert.New(t)
+	long := strings.Repeat("a", 20)
+	bytes := []byte(strings.Repeat("b", 20))
+
+	query, err := MySQL.InterpolateTruncated("SELECT ?, ?, ?, ?", []interface{}{long, bytes, "ok", 123}, 3)
+	a.NilError(err)
+	a.Equal(query, "SELECT 'aaa...(20 bytes)', _binary'bbb' /* ...(20 bytes) */, 'ok', 123")
+
+	query, err = PostgreSQL.InterpolateTruncated("SELECT $2, $1", []interface{}{"你好世界", bytes}, 4)
+	a.NilError(err)
+	a.Equal(query, "SELECT E'\\\\x62626262'::bytea /* ...(20 bytes) */, E'你...(12 bytes)'")
+
+	// Not truncated if maxLen is not positive.
+	query, err = SQLite.InterpolateTruncated("SELECT ?", []interface{}{long}, 0)
+	a.NilError(err)
+	a.Equal(query, "SELECT '"+long+"'")
+
+	_, err = MySQL.InterpolateTruncated("SELECT ?", []interface{}{errorValuer(1)}, 3)
+	a.Equal(err, ErrErrorValuer)
+
+	var nilValuer *timeValuer
+	query, err = MySQL.InterpolateTruncated("SELECT ?", []interface{}{nilValuer}, 3)
+	a.NilError(err)
+	a.Equal(query, "SELECT NULL")
+}
+
+func TestRebind(t *testing.T) {
+	a := assert.New(t)
+	query := "SELECT * FROM `a?` WHERE name = 'a?b' AND note = \"?\" AND x = ? AND y IN (?, ?) AND z = 'it\\'s?'"
+
+	a.Equal(Rebind(PostgreSQL, query), "SELECT * FROM `a?` WHERE name = 'a?b' AND note = \"?\" AND x = $1 AND y IN ($2, $3) AND z = 'it\\'s?'")
+	a.Equal(Rebind(SQLServer, query), "SELECT * FROM `a?` WHERE name = 'a?b' AND note = \"?\" AND x = @p1 AND y IN (@p2, @p3) AND z = 'it\\'s?'")
+	a.Equal(Rebind(Oracle, query), "SELECT * FROM `a?` WHERE name = 'a?b' AND note = \"?\" AND x = :1 AND y IN (:2, :3) AND z = 'it\\'s?'")
+	a.Equal(Rebind(MySQL, query), query)
+	a.Equal(Rebind(PostgreSQL, ""), "")
+}
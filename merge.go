@@ -0,0 +1,315 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"fmt"
+)
+
+const (
+	mergeMarkerInit injectionMarker = iota
+	mergeMarkerAfterMergeInto
+	mergeMarkerAfterUsing
+	mergeMarkerAfterOn
+	mergeMarkerAfterWhen
+)
+
+// NewMergeBuilder creates a new MERGE builder.
+func NewMergeBuilder() *MergeBuilder {
+	return DefaultFlavor.NewMergeBuilder()
+}
+
+func newMergeBuilder() *MergeBuilder {
+	args := &Args{}
+	return &MergeBuilder{
+		Cond: Cond{
+			Args: args,
+		},
+		args:      args,
+		injection: newInjection(),
+	}
+}
+
+// MergeBuilder is a builder to build MERGE.
+//
+// MERGE is supported by SQLServer, Oracle and PostgreSQL 15+.
+// The syntax is slightly different in these flavors.
+//
+//   - In Oracle, the source alias is written without AS and the ON condition is wrapped by parentheses.
+//     The condition of a WHEN clause is written as a WHERE after the action.
+//   - In SQLServer, a semicolon is written at the end of the statement as required.
+//   - In other flavors, the standard syntax is used.
+type MergeBuilder struct {
+	Cond
+
+	table       string
+	usingTable  string
+	usingVar    string
+	usingAlias  string
+	onExprs     []string
+	whenClauses []*MergeWhenClause
+
+	args *Args
+
+	injection *injection
+	marker    injectionMarker
+}
+
+var _ Builder = new(MergeBuilder)
+
+// MergeWhenClause is a WHEN [NOT] MATCHED clause in MERGE.
+// It's created by `MergeBuilder#WhenMatched` or `MergeBuilder#WhenNotMatched`.
+type MergeWhenClause struct {
+	mb      *MergeBuilder
+	matched bool
+	conds   []string
+
+	action      string
+	assignments []string
+	cols        []string
+	values      []string
+}
+
+// MergeInto sets the target table in MERGE.
+func MergeInto(table string) *MergeBuilder {
+	return DefaultFlavor.NewMergeBuilder().MergeInto(table)
+}
+
+// MergeInto sets the target table in MERGE.
+func (mb *MergeBuilder) MergeInto(table string) *MergeBuilder {
+	mb.table = Escape(table)
+	mb.marker = mergeMarkerAfterMergeInto
+	return mb
+}
+
+// Using sets a subquery as the source of MERGE with alias.
+func (mb *MergeBuilder) Using(source Builder, alias string) *MergeBuilder {
+	mb.usingTable = ""
+	mb.usingVar = mb.Var(source)
+	mb.usingAlias = Escape(alias)
+	mb.marker = mergeMarkerAfterUsing
+	return mb
+}
+
+// UsingTable sets a table as the source of MERGE.
+// The table can contain an alias, e.g. "new_users s".
+func (mb *MergeBuilder) UsingTable(table string) *MergeBuilder {
+	mb.usingTable = Escape(table)
+	mb.usingVar = ""
+	mb.usingAlias = ""
+	mb.marker = mergeMarkerAfterUsing
+	return mb
+}
+
+// On sets the join condition between the target and the source in MERGE.
+func (mb *MergeBuilder) On(onExpr ...string) *MergeBuilder {
+	mb.onExprs = append(mb.onExprs, onExpr...)
+	mb.marker = mergeMarkerAfterOn
+	return mb
+}
+
+// WhenMatched adds a WHEN MATCHED clause with optional conditions.
+// Call `MergeWhenClause#ThenUpdate` or `MergeWhenClause#ThenDelete` to set the action.
+func (mb *MergeBuilder) WhenMatched(andCond ...string) *MergeWhenClause {
+	return mb.addWhen(true, andCond)
+}
+
+// WhenNotMatched adds a WHEN NOT MATCHED clause with optional conditions.
+// Call `MergeWhenClause#ThenInsert` to set the action.
+func (mb *MergeBuilder) WhenNotMatched(andCond ...string) *MergeWhenClause {
+	return mb.addWhen(false, andCond)
+}
+
+func (mb *MergeBuilder) addWhen(matched bool, andCond []string) *MergeWhenClause {
+	if estimateStringsBytes(andCond) == 0 {
+		andCond = nil
+	}
+
+	when := &MergeWhenClause{
+		mb:      mb,
+		matched: matched,
+		conds:   andCond,
+	}
+	mb.whenClauses = append(mb.whenClauses, when)
+	mb.marker = mergeMarkerAfterWhen
+	return when
+}
+
+// Assign represents SET "field = value" in WHEN MATCHED THEN UPDATE.
+func (mb *MergeBuilder) Assign(field string, value interface{}) string {
+	return fmt.Sprintf("%s = %s", Escape(field), mb.args.Add(value))
+}
+
+// ThenUpdate sets the action to UPDATE SET assignment...
+// Assignments can be built with `MergeBuilder#Assign`.
+func (when *MergeWhenClause) ThenUpdate(assignment ...string) *MergeBuilder {
+	when.action = "UPDATE"
+	when.assignments = assignment
+	return when.mb
+}
+
+// ThenDelete sets the action to DELETE.
+func (when *MergeWhenClause) ThenDelete() *MergeBuilder {
+	when.action = "DELETE"
+	return when.mb
+}
+
+// ThenInsert sets the action to INSERT (cols...) VALUES (values...).
+// Values are bound as args. Use `Cond#Col` or `Raw` to refer columns in source, e.g. mb.Col("s.name").
+func (when *MergeWhenClause) ThenInsert(cols []string, values ...interface{}) *MergeBuilder {
+	placeholders := make([]string, 0, len(values))
+
+	for _, v := range values {
+		placeholders = append(placeholders, when.mb.args.Add(v))
+	}
+
+	when.action = "INSERT"
+	when.cols = EscapeAll(cols...)
+	when.values = placeholders
+	return when.mb
+}
+
+// ThenDoNothing sets the action to DO NOTHING.
+// It's supported by PostgreSQL only.
+func (when *MergeWhenClause) ThenDoNothing() *MergeBuilder {
+	when.action = "DO NOTHING"
+	return when.mb
+}
+
+func (when *MergeWhenClause) writeTo(buf *stringBuilder, flavor Flavor) {
+	if when.matched {
+		buf.WriteLeadingString("WHEN MATCHED")
+	} else {
+		buf.WriteLeadingString("WHEN NOT MATCHED")
+	}
+
+	if len(when.conds) > 0 && flavor != Oracle {
+		buf.WriteString(" AND ")
+		buf.WriteStrings(when.conds, " AND ")
+	}
+
+	buf.WriteString(" THEN ")
+
+	switch when.action {
+	case "UPDATE":
+		buf.WriteString("UPDATE SET ")
+		buf.WriteStrings(when.assignments, ", ")
+
+	case "INSERT":
+		buf.WriteString("INSERT")
+
+		if len(when.cols) > 0 {
+			buf.WriteString(" (")
+			buf.WriteStrings(when.cols, ", ")
+			buf.WriteString(")")
+		}
+
+		buf.WriteString(" VALUES (")
+		buf.WriteStrings(when.values, ", ")
+		buf.WriteString(")")
+
+	default:
+		buf.WriteString(when.action)
+	}
+
+	// Oracle writes conditions in WHERE after the action.
+	if len(when.conds) > 0 && flavor == Oracle {
+		buf.WriteString(" WHERE ")
+		buf.WriteStrings(when.conds, " AND ")
+	}
+}
+
+// String returns the compiled MERGE string.
+func (mb *MergeBuilder) String() string {
+	s, _ := mb.Build()
+	return s
+}
+
+// Build returns compiled MERGE string and args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (mb *MergeBuilder) Build() (sql string, args []interface{}) {
+	return mb.BuildWithFlavor(mb.args.Flavor)
+}
+
+// BuildWithFlavor returns compiled MERGE string and args with flavor and initial args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (mb *MergeBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	buf := newStringBuilder()
+	mb.injection.WriteTo(buf, mergeMarkerInit)
+
+	if len(mb.table) > 0 {
+		buf.WriteLeadingString("MERGE INTO ")
+		buf.WriteString(mb.table)
+	}
+
+	mb.injection.WriteTo(buf, mergeMarkerAfterMergeInto)
+
+	if mb.usingVar != "" {
+		buf.WriteLeadingString("USING (")
+		buf.WriteString(mb.usingVar)
+		buf.WriteString(")")
+
+		if mb.usingAlias != "" {
+			if flavor == Oracle {
+				buf.WriteString(" ")
+			} else {
+				buf.WriteString(" AS ")
+			}
+
+			buf.WriteString(mb.usingAlias)
+		}
+
+		mb.injection.WriteTo(buf, mergeMarkerAfterUsing)
+	} else if mb.usingTable != "" {
+		buf.WriteLeadingString("USING ")
+		buf.WriteString(mb.usingTable)
+		mb.injection.WriteTo(buf, mergeMarkerAfterUsing)
+	}
+
+	if len(mb.onExprs) > 0 {
+		buf.WriteLeadingString("ON ")
+
+		if flavor == Oracle {
+			buf.WriteString("(")
+			buf.WriteStrings(mb.onExprs, " AND ")
+			buf.WriteString(")")
+		} else {
+			buf.WriteStrings(mb.onExprs, " AND ")
+		}
+
+		mb.injection.WriteTo(buf, mergeMarkerAfterOn)
+	}
+
+	if len(mb.whenClauses) > 0 {
+		for _, when := range mb.whenClauses {
+			when.writeTo(buf, flavor)
+		}
+
+		mb.injection.WriteTo(buf, mergeMarkerAfterWhen)
+	}
+
+	if flavor == SQLServer && len(mb.table) > 0 {
+		buf.WriteString(";")
+	}
+
+	return mb.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+}
+
+// SetFlavor sets the flavor of compiled sql.
+func (mb *MergeBuilder) SetFlavor(flavor Flavor) (old Flavor) {
+	old = mb.args.Flavor
+	mb.args.Flavor = flavor
+	return
+}
+
+// Flavor returns flavor of builder
+func (mb *MergeBuilder) Flavor() Flavor {
+	return mb.args.Flavor
+}
+
+// SQL adds an arbitrary sql to current position.
+func (mb *MergeBuilder) SQL(sql string) *MergeBuilder {
+	mb.injection.SQL(mb.marker, sql)
+	return mb
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleMergeInto() {
+	src := Select("id", "name").From("new_users").Where("updated_at > created_at")
+
+	mb := PostgreSQL.NewMergeBuilder()
+	mb.MergeInto("users u").Using(src, "s").On("u.id = s.id")
+	mb.WhenMatched().ThenUpdate("name = s.name", mb.Assign("status", 1))
+	mb.WhenNotMatched().ThenInsert([]string{"id", "name", "status"}, mb.Col("s.id"), mb.Col("s.name"), 0)
+
+	sql, args := mb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// MERGE INTO users u USING (SELECT id, name FROM new_users WHERE updated_at > created_at) AS s ON u.id = s.id WHEN MATCHED THEN UPDATE SET name = s.name, status = $1 WHEN NOT MATCHED THEN INSERT (id, name, status) VALUES (s.id, s.name, $2)
+	// [1 0]
+}
+
+func ExampleMergeBuilder_SQL() {
+	mb := NewMergeBuilder()
+	mb.SQL("/* before */")
+	mb.MergeInto("users")
+	mb.SQL("/* after merge into */")
+	mb.UsingTable("new_users s")
+	mb.SQL("/* after using */")
+	mb.On("users.id = s.id")
+	mb.SQL("/* after on */")
+	mb.WhenMatched("s.deleted = 1").ThenDelete()
+	mb.SQL("/* after when */")
+
+	fmt.Println(mb)
+
+	// Output:
+	// /* before */ MERGE INTO users /* after merge into */ USING new_users s /* after using */ ON users.id = s.id /* after on */ WHEN MATCHED AND s.deleted = 1 THEN DELETE /* after when */
+}
+
+func TestMergeBuilderFlavors(t *testing.T) {
+	a := assert.New(t)
+	build := func(flavor Flavor) string {
+		mb := flavor.NewMergeBuilder()
+		mb.MergeInto("t").Using(Select("id", "v").From("src"), "s").On("t.id = s.id")
+		mb.WhenMatched("s.v > 0").ThenUpdate("v = s.v")
+		mb.WhenNotMatched().ThenInsert([]string{"id", "v"}, mb.Col("s.id"), mb.Col("s.v"))
+		return mb.String()
+	}
+
+	a.Equal(build(SQLServer), "MERGE INTO t USING (SELECT id, v FROM src) AS s ON t.id = s.id WHEN MATCHED AND s.v > 0 THEN UPDATE SET v = s.v WHEN NOT MATCHED THEN INSERT (id, v) VALUES (s.id, s.v);")
+	a.Equal(build(Oracle), "MERGE INTO t USING (SELECT id, v FROM src) s ON (t.id = s.id) WHEN MATCHED THEN UPDATE SET v = s.v WHERE s.v > 0 WHEN NOT MATCHED THEN INSERT (id, v) VALUES (s.id, s.v)")
+	a.Equal(build(PostgreSQL), "MERGE INTO t USING (SELECT id, v FROM src) AS s ON t.id = s.id WHEN MATCHED AND s.v > 0 THEN UPDATE SET v = s.v WHEN NOT MATCHED THEN INSERT (id, v) VALUES (s.id, s.v)")
+
+	mb := PostgreSQL.NewMergeBuilder()
+	mb.MergeInto("t").UsingTable("src s").On("t.id = s.id").WhenMatched("", "").ThenDoNothing()
+	a.Equal(mb.String(), "MERGE INTO t USING src s ON t.id = s.id WHEN MATCHED THEN DO NOTHING")
+}
+
+func TestMergeBuilderGetFlavor(t *testing.T) {
+	a := assert.New(t)
+	mb := newMergeBuilder()
+
+	mb.SetFlavor(PostgreSQL)
+	a.Equal(PostgreSQL, mb.Flavor())
+
+	mbOracle := Oracle.NewMergeBuilder()
+	a.Equal(Oracle, mbOracle.Flavor())
+}
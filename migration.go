@@ -0,0 +1,165 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrMigrationIrreversible means that the down builder cannot be derived from the up builder.
+var ErrMigrationIrreversible = errors.New("go-sqlbuilder: migration is irreversible")
+
+// Migration is a pair of builders to migrate database schema.
+// The Up applies a change and the Down reverts it.
+type Migration struct {
+	Up   Builder
+	Down Builder
+}
+
+// NewMigration creates a new Migration with up and the down builder derived from up.
+//
+// Here is a list of supported up builders and the derived down builders.
+//   - CreateTableBuilder: DropTableBuilder (with IF EXISTS if the up has IF NOT EXISTS).
+//   - CreateIndexBuilder: DropIndexBuilder on the same table (with IF EXISTS if the up has IF NOT EXISTS).
+//   - AlterTableBuilder: AlterTableBuilder reverting actions in reverse order.
+//     AddColumn is reverted by DropColumn and RenameColumn by renaming the column back.
+//     DropColumn and AddIndex are irreversible as the column definition or index is unknown.
+//   - GrantBuilder: REVOKE with the same privileges, object and grantees.
+//   - RevokeBuilder: GRANT with the same privileges, object and grantees.
+//
+// Other builders, e.g. SELECT, UPDATE and raw SQL built by `Build`, are irreversible.
+// NewMigration returns ErrMigrationIrreversible for them.
+// In this case, set Down manually in a Migration literal if it's possible to revert the change.
+func NewMigration(up Builder) (*Migration, error) {
+	down, err := reverseMigration(up)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migration{
+		Up:   up,
+		Down: down,
+	}, nil
+}
+
+func reverseMigration(up Builder) (Builder, error) {
+	switch b := up.(type) {
+	case *CreateTableBuilder:
+		if b.table == "" {
+			break
+		}
+
+		// The table name has been escaped in CreateTableBuilder.
+		dtb := b.Flavor().NewDropTableBuilder()
+		dtb.tables = []string{b.table}
+		dtb.marker = dropTableMarkerAfterDrop
+		dtb.ifExists = b.ifNotExists
+		return dtb, nil
+
+	case *CreateIndexBuilder:
+		if b.name == "" {
+			break
+		}
+
+		// Names have been escaped in CreateIndexBuilder.
+		dib := b.Flavor().NewDropIndexBuilder()
+		dib.name = b.name
+		dib.table = b.table
+		dib.marker = dropIndexMarkerAfterOn
+		dib.ifExists = b.ifNotExists
+		return dib, nil
+
+	case *AlterTableBuilder:
+		return reverseAlterTable(b)
+
+	case *GrantBuilder:
+		if len(b.privileges) == 0 {
+			break
+		}
+
+		rb := b.Flavor().NewRevokeBuilder()
+		rb.Revoke(b.privileges...).OnObject(b.objectType, b.object).From(b.grantees...)
+		return rb, nil
+
+	case *RevokeBuilder:
+		if len(b.privileges) == 0 {
+			break
+		}
+
+		gb := b.Flavor().NewGrantBuilder()
+		gb.Grant(b.privileges...).OnObject(b.objectType, b.object).To(b.grantees...)
+
+		if b.grantOptionFor {
+			gb.WithGrantOption()
+		}
+
+		return gb, nil
+	}
+
+	return nil, ErrMigrationIrreversible
+}
+
+func reverseAlterTable(atb *AlterTableBuilder) (Builder, error) {
+	if atb.table == "" || len(atb.actions) == 0 {
+		return nil, ErrMigrationIrreversible
+	}
+
+	down := atb.Flavor().NewAlterTableBuilder()
+
+	// The table name has been escaped in AlterTableBuilder.
+	down.table = atb.table
+	down.marker = alterTableMarkerAfterAlter
+
+	for i := len(atb.actions) - 1; i >= 0; i-- {
+		action := atb.actions[i]
+
+		switch action.kind {
+		case alterTableAddColumn:
+			fields := strings.Fields(strings.Join(action.args, " "))
+
+			if len(fields) == 0 {
+				return nil, ErrMigrationIrreversible
+			}
+
+			down.addAction(alterTableDropColumn, Escape(fields[0]), nil)
+
+		case alterTableRenameColumn:
+			down.addAction(alterTableRenameColumn, action.args[0], []string{action.name})
+
+		default:
+			return nil, ErrMigrationIrreversible
+		}
+	}
+
+	return down, nil
+}
+
+// Build builds both up and down SQL with flavor.
+// Args are interpolated in the SQL, so that the SQL can be saved in migration files directly.
+//
+// If Down is nil, down is empty.
+func (m *Migration) Build(flavor Flavor) (up, down string, err error) {
+	if up, err = buildInterpolated(m.Up, flavor); err != nil {
+		return
+	}
+
+	down, err = buildInterpolated(m.Down, flavor)
+	return
+}
+
+func buildInterpolated(b Builder, flavor Flavor) (string, error) {
+	if b == nil {
+		return "", nil
+	}
+
+	sql, args := b.BuildWithFlavor(flavor)
+
+	if len(args) == 0 {
+		return sql, nil
+	}
+
+	return flavor.Interpolate(sql, args)
+}
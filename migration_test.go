@@ -0,0 +1,91 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleNewMigration() {
+	ctb := CreateTable("demo.user").IfNotExists()
+	ctb.Define("id", "BIGINT(20)", "NOT NULL", "AUTO_INCREMENT", "PRIMARY KEY")
+	ctb.Define("name", "VARCHAR(255)", "NOT NULL")
+
+	m, err := NewMigration(ctb)
+
+	if err != nil {
+		panic(err)
+	}
+
+	up, down, err := m.Build(MySQL)
+	fmt.Println(up)
+	fmt.Println(down)
+	fmt.Println(err)
+
+	// Output:
+	// CREATE TABLE IF NOT EXISTS demo.user (id BIGINT(20) NOT NULL AUTO_INCREMENT PRIMARY KEY, name VARCHAR(255) NOT NULL)
+	// DROP TABLE IF EXISTS demo.user
+	// <nil>
+}
+
+func TestMigration(t *testing.T) {
+	a := assert.New(t)
+
+	m, err := NewMigration(PostgreSQL.NewCreateTableBuilder().CreateTable("t$1").Define("id", "INT"))
+	a.NilError(err)
+	up, down, err := m.Build(PostgreSQL)
+	a.NilError(err)
+	a.Equal(up, "CREATE TABLE t$1 (id INT)")
+	a.Equal(down, "DROP TABLE t$1")
+	_, ok := m.Down.(*DropTableBuilder)
+	a.Assert(ok)
+
+	m, err = NewMigration(Grant("SELECT", "INSERT").On("t").To("r").WithGrantOption())
+	a.NilError(err)
+	up, down, err = m.Build(PostgreSQL)
+	a.NilError(err)
+	a.Equal(up, `GRANT SELECT, INSERT ON TABLE "t" TO r WITH GRANT OPTION`)
+	a.Equal(down, `REVOKE SELECT, INSERT ON TABLE "t" FROM r`)
+
+	m, err = NewMigration(Revoke("USAGE").GrantOptionFor().OnObject("SCHEMA", "s").From("r"))
+	a.NilError(err)
+	up, down, err = m.Build(PostgreSQL)
+	a.NilError(err)
+	a.Equal(up, `REVOKE GRANT OPTION FOR USAGE ON SCHEMA "s" FROM r`)
+	a.Equal(down, `GRANT USAGE ON SCHEMA "s" TO r WITH GRANT OPTION`)
+
+	m, err = NewMigration(PostgreSQL.NewCreateIndexBuilder().CreateIndex("idx").IfNotExists().On("t", "a"))
+	a.NilError(err)
+	_, down, err = m.Build(PostgreSQL)
+	a.NilError(err)
+	a.Equal(down, "DROP INDEX IF EXISTS idx")
+	_, down, err = m.Build(MySQL)
+	a.NilError(err)
+	a.Equal(down, "DROP INDEX idx ON t")
+
+	m, err = NewMigration(AlterTable("t").AddColumn("c INT").AddColumn("d", "TEXT", "NOT NULL").RenameColumn("x", "y"))
+	a.NilError(err)
+	_, ok = m.Down.(*AlterTableBuilder)
+	a.Assert(ok)
+	_, down, err = m.Build(MySQL)
+	a.NilError(err)
+	a.Equal(down, "ALTER TABLE t RENAME COLUMN y TO x, DROP COLUMN d, DROP COLUMN c")
+
+	_, err = NewMigration(AlterTable("t").AddColumn("c INT").DropColumn("d"))
+	a.Equal(err, ErrMigrationIrreversible)
+	_, err = NewMigration(Select("*").From("t"))
+	a.Equal(err, ErrMigrationIrreversible)
+	_, err = NewMigration(NewCreateTableBuilder())
+	a.Equal(err, ErrMigrationIrreversible)
+
+	// Args are interpolated and Down can be nil.
+	m = &Migration{Up: Build("INSERT INTO t VALUES ($?)", "it's")}
+	up, down, err = m.Build(PostgreSQL)
+	a.NilError(err)
+	a.Equal(up, "INSERT INTO t VALUES (E'it\\'s')")
+	a.Equal(down, "")
+}
@@ -24,6 +24,60 @@ func EscapeAll(ident ...string) []string {
 	return escaped
 }
 
+// EscapeLikePattern escapes all LIKE metacharacters, i.e. `%`, `_` and `\`, in s with backslash,
+// so that s can be used as a literal string in a LIKE pattern.
+// The escaped pattern should be used with an `ESCAPE '\'` clause in SQL.
+func EscapeLikePattern(s string) string {
+	return likePatternEscaper.Replace(s)
+}
+
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// EstimateBytes returns the total number of bytes in strs.
+// It's useful to pre-allocate buffer when joining strs together.
+//
+// As it returns 0 when all strings in strs are empty, it can also be used to ignore empty expressions
+// in the same way as this package does.
+//
+//	func MyAnd(exprs ...string) string {
+//	    n := sqlbuilder.EstimateBytes(exprs)
+//
+//	    if n == 0 {
+//	        return ""
+//	    }
+//
+//	    var sb strings.Builder
+//	    sb.Grow(n + len(exprs)*len(" AND ") + 2)
+//	    // Write exprs to sb.
+//	    return sb.String()
+//	}
+func EstimateBytes(strs []string) int {
+	return estimateStringsBytes(strs)
+}
+
+// Agg returns an aggregate function call "fn(expr)" or "fn(DISTINCT expr)" if distinct is true.
+// The result can be used in `SelectBuilder#Select` or `SelectBuilder#Having` directly.
+//
+//	Agg("SUM", true, "amount") // SUM(DISTINCT amount)
+//
+// DISTINCT in COUNT, SUM, AVG, MIN and MAX is supported by all major databases.
+// Other aggregates with DISTINCT, e.g. array_agg and string_agg in PostgreSQL or GROUP_CONCAT in MySQL,
+// are flavor specific. SQLite only supports DISTINCT in aggregates with a single argument.
+func Agg(fn string, distinct bool, expr string) string {
+	buf := newStringBuilder()
+	buf.Grow(len(fn) + len(expr) + len("(DISTINCT )"))
+	buf.WriteString(fn)
+	buf.WriteRune('(')
+
+	if distinct {
+		buf.WriteString("DISTINCT ")
+	}
+
+	buf.WriteString(expr)
+	buf.WriteRune(')')
+	return buf.String()
+}
+
 // Flatten recursively extracts values in slices and returns
 // a flattened []interface{} with all values.
 // If slices is not a slice, return `[]interface{}{slices}`.
@@ -77,6 +131,20 @@ func Raw(expr string) interface{} {
 	return rawArgs{expr}
 }
 
+type colOperand struct {
+	name string
+}
+
+// fieldExpr is an expression used as a field in Cond methods, e.g. the one returned by `Cond#JSONExtract`.
+// It's written in place of a field which is exactly its var ref.
+type fieldExpr struct {
+	Builder func(ctx *argsCompileContext)
+}
+
+type valueOperand struct {
+	value interface{}
+}
+
 type listArgs struct {
 	args    []interface{}
 	isTuple bool
@@ -31,6 +31,42 @@ func TestEscape(t *testing.T) {
 	a.Equal(actuals, expects)
 }
 
+func TestEscapeLikePattern(t *testing.T) {
+	a := assert.New(t)
+	cases := map[string]string{
+		"abc":    "abc",
+		"50%":    `50\%`,
+		"a_b":    `a\_b`,
+		`a\b`:    `a\\b`,
+		`%_\%_\`: `\%\_\\\%\_\\`,
+	}
+
+	for s, expected := range cases {
+		a.Equal(EscapeLikePattern(s), expected)
+	}
+}
+
+func TestEstimateBytes(t *testing.T) {
+	a := assert.New(t)
+	a.Equal(EstimateBytes(nil), 0)
+	a.Equal(EstimateBytes([]string{"", ""}), 0)
+	a.Equal(EstimateBytes([]string{"a = 1", "", "bc"}), 7)
+}
+
+func ExampleAgg() {
+	sb := Select("user_id", Agg("SUM", true, "amount")+" AS total", Agg("COUNT", false, "*"))
+	sb.From("orders").GroupBy("user_id")
+	sb.Having(sb.GreaterThan(Agg("SUM", true, "amount"), 100))
+
+	sql, args := sb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT user_id, SUM(DISTINCT amount) AS total, COUNT(*) FROM orders GROUP BY user_id HAVING SUM(DISTINCT amount) > ?
+	// [100]
+}
+
 func TestFlatten(t *testing.T) {
 	a := assert.New(t)
 	cases := [][2]interface{}{
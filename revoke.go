@@ -0,0 +1,165 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+const (
+	revokeMarkerInit injectionMarker = iota
+	revokeMarkerAfterRevoke
+	revokeMarkerAfterOn
+	revokeMarkerAfterFrom
+)
+
+// NewRevokeBuilder creates a new REVOKE builder.
+func NewRevokeBuilder() *RevokeBuilder {
+	return DefaultFlavor.NewRevokeBuilder()
+}
+
+func newRevokeBuilder() *RevokeBuilder {
+	return &RevokeBuilder{
+		args:      &Args{},
+		injection: newInjection(),
+	}
+}
+
+// RevokeBuilder is a builder to build REVOKE.
+type RevokeBuilder struct {
+	grantOptionFor bool
+	privileges     []string
+	objectType     string
+	object         string
+	grantees       []string
+	cascade        bool
+
+	args *Args
+
+	injection *injection
+	marker    injectionMarker
+}
+
+var _ Builder = new(RevokeBuilder)
+
+// Revoke sets privileges in REVOKE.
+func Revoke(privilege ...string) *RevokeBuilder {
+	return DefaultFlavor.NewRevokeBuilder().Revoke(privilege...)
+}
+
+// Revoke sets privileges in REVOKE.
+func (rb *RevokeBuilder) Revoke(privilege ...string) *RevokeBuilder {
+	rb.privileges = privilege
+	rb.marker = revokeMarkerAfterRevoke
+	return rb
+}
+
+// GrantOptionFor revokes the grant option only rather than the privileges.
+// It's written as "REVOKE GRANT OPTION FOR ..." in PostgreSQL and SQLServer.
+// For MySQL, it's written as "REVOKE ..., GRANT OPTION ...".
+func (rb *RevokeBuilder) GrantOptionFor() *RevokeBuilder {
+	rb.grantOptionFor = true
+	rb.marker = revokeMarkerAfterRevoke
+	return rb
+}
+
+// On sets the table to revoke privileges on.
+// See doc in `GrantBuilder#On` for how the object name is quoted.
+func (rb *RevokeBuilder) On(object string) *RevokeBuilder {
+	return rb.OnObject("", object)
+}
+
+// OnObject sets the object to revoke privileges on with an explicit object type,
+// e.g. "SCHEMA", "DATABASE" or "SEQUENCE".
+// If objectType is empty, the object is a table.
+func (rb *RevokeBuilder) OnObject(objectType, object string) *RevokeBuilder {
+	rb.objectType = objectType
+	rb.object = object
+	rb.marker = revokeMarkerAfterOn
+	return rb
+}
+
+// From sets grantees in REVOKE.
+// Grantees are not quoted.
+func (rb *RevokeBuilder) From(grantee ...string) *RevokeBuilder {
+	rb.grantees = grantee
+	rb.marker = revokeMarkerAfterFrom
+	return rb
+}
+
+// Cascade adds CASCADE at the end of REVOKE.
+// It's only supported by PostgreSQL and SQLServer and ignored in other flavors.
+func (rb *RevokeBuilder) Cascade() *RevokeBuilder {
+	rb.cascade = true
+	rb.marker = revokeMarkerAfterFrom
+	return rb
+}
+
+// String returns the compiled REVOKE string.
+func (rb *RevokeBuilder) String() string {
+	s, _ := rb.Build()
+	return s
+}
+
+// Build returns compiled REVOKE string and args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (rb *RevokeBuilder) Build() (sql string, args []interface{}) {
+	return rb.BuildWithFlavor(rb.args.Flavor)
+}
+
+// BuildWithFlavor returns compiled REVOKE string and args with flavor and initial args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (rb *RevokeBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	buf := newStringBuilder()
+	rb.injection.WriteTo(buf, revokeMarkerInit)
+
+	if len(rb.privileges) > 0 {
+		buf.WriteLeadingString("REVOKE ")
+
+		if rb.grantOptionFor && !flavor.isMySQLCompatible() {
+			buf.WriteString("GRANT OPTION FOR ")
+		}
+
+		buf.WriteStrings(rb.privileges, ", ")
+
+		if rb.grantOptionFor && flavor.isMySQLCompatible() {
+			buf.WriteString(", GRANT OPTION")
+		}
+	}
+
+	rb.injection.WriteTo(buf, revokeMarkerAfterRevoke)
+
+	if rb.object != "" {
+		buf.WriteLeadingString("ON ")
+		writePrivilegeObject(buf, flavor, rb.objectType, rb.object)
+	}
+
+	rb.injection.WriteTo(buf, revokeMarkerAfterOn)
+
+	if len(rb.grantees) > 0 {
+		buf.WriteLeadingString("FROM ")
+		buf.WriteStrings(rb.grantees, ", ")
+
+		if rb.cascade && (flavor == PostgreSQL || flavor == SQLServer) {
+			buf.WriteString(" CASCADE")
+		}
+	}
+
+	rb.injection.WriteTo(buf, revokeMarkerAfterFrom)
+	return rb.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+}
+
+// SetFlavor sets the flavor of compiled sql.
+func (rb *RevokeBuilder) SetFlavor(flavor Flavor) (old Flavor) {
+	old = rb.args.Flavor
+	rb.args.Flavor = flavor
+	return
+}
+
+// Flavor returns flavor of builder
+func (rb *RevokeBuilder) Flavor() Flavor {
+	return rb.args.Flavor
+}
+
+// SQL adds an arbitrary sql to current position.
+func (rb *RevokeBuilder) SQL(sql string) *RevokeBuilder {
+	rb.injection.SQL(rb.marker, sql)
+	return rb
+}
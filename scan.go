@@ -0,0 +1,128 @@
+// Copyright 2018 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"errors"
+	"reflect"
+)
+
+// ErrScanInvalidDest is returned by `Struct#ScanRow` and `Struct#ScanRows`
+// when the type of dest doesn't match the struct type.
+var ErrScanInvalidDest = errors.New("go-sqlbuilder: invalid scan dest for the struct type")
+
+// Rows is the iterator of query results used by `Struct#ScanRow` and `Struct#ScanRows`.
+// The *sql.Rows in package database/sql implements it.
+type Rows interface {
+	Columns() ([]string, error)
+	Next() bool
+	Scan(dest ...interface{}) error
+	Err() error
+}
+
+// ScanRow scans current row in rows into dest, which must be a pointer to the struct type of s.
+// Caller must call rows.Next() before calling ScanRow.
+//
+// Unlike `Addr`, scan targets are ordered by the columns returned by rows rather than struct fields,
+// so that a custom SELECT with different column order can be scanned correctly.
+// Columns which don't exist in s are skipped.
+func (s *Struct) ScanRow(rows Rows, dest interface{}) error {
+	v := reflect.ValueOf(dest)
+
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Type() != s.structType {
+		return ErrScanInvalidDest
+	}
+
+	cols, err := rows.Columns()
+
+	if err != nil {
+		return err
+	}
+
+	return rows.Scan(addrWithFieldsOrSink(s.fieldsForCols(cols), v.Elem())...)
+}
+
+// ScanRows scans all rows into dest, which must be a pointer to a slice of the struct type of s
+// or a pointer to a slice of pointers to the struct type.
+// A new element is appended to dest for every row.
+//
+// Columns returned by rows are mapped to struct fields by their column names or aliases set by `fieldas`.
+// Columns which don't exist in s are skipped.
+//
+// ScanRows doesn't close rows. Caller is responsible to close it.
+func (s *Struct) ScanRows(rows Rows, dest interface{}) error {
+	v := reflect.ValueOf(dest)
+
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Slice {
+		return ErrScanInvalidDest
+	}
+
+	slice := v.Elem()
+	elemType := slice.Type().Elem()
+	isPtr := elemType.Kind() == reflect.Ptr
+
+	if dereferencedType(elemType) != s.structType {
+		return ErrScanInvalidDest
+	}
+
+	cols, err := rows.Columns()
+
+	if err != nil {
+		return err
+	}
+
+	fields := s.fieldsForCols(cols)
+
+	for rows.Next() {
+		elem := reflect.New(s.structType)
+
+		if err := rows.Scan(addrWithFieldsOrSink(fields, elem.Elem())...); err != nil {
+			return err
+		}
+
+		if isPtr {
+			slice = reflect.Append(slice, elem)
+		} else {
+			slice = reflect.Append(slice, elem.Elem())
+		}
+	}
+
+	v.Elem().Set(slice)
+	return rows.Err()
+}
+
+// fieldsForCols returns the fields matching cols in order.
+// If a column doesn't exist in s, its field is nil.
+func (s *Struct) fieldsForCols(cols []string) []*structField {
+	sfs := s.structFieldsParser()
+	tagged := sfs.FilterTags(s.withTags, s.withoutTags)
+	fields := make([]*structField, len(cols))
+
+	if tagged == nil {
+		return fields
+	}
+
+	for i, col := range cols {
+		fields[i] = tagged.colsForRead[col]
+	}
+
+	return fields
+}
+
+// addrWithFieldsOrSink takes address of all fields in v.
+// A nil field is replaced by a sink to discard the scanned value.
+func addrWithFieldsOrSink(fields []*structField, v reflect.Value) []interface{} {
+	addrs := make([]interface{}, 0, len(fields))
+
+	for _, sf := range fields {
+		if sf == nil {
+			addrs = append(addrs, new(interface{}))
+			continue
+		}
+
+		addrs = append(addrs, v.FieldByName(sf.Name).Addr().Interface())
+	}
+
+	return addrs
+}
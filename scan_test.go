@@ -0,0 +1,170 @@
+// Copyright 2018 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+type scanRowsForTest struct {
+	cols []string
+	data [][]interface{}
+	idx  int
+	err  error
+}
+
+func (rows *scanRowsForTest) Columns() ([]string, error) {
+	return rows.cols, nil
+}
+
+func (rows *scanRowsForTest) Next() bool {
+	if rows.idx >= len(rows.data) {
+		return false
+	}
+
+	rows.idx++
+	return true
+}
+
+func (rows *scanRowsForTest) Scan(dest ...interface{}) error {
+	if rows.err != nil {
+		return rows.err
+	}
+
+	row := rows.data[rows.idx-1]
+
+	for i, d := range dest {
+		switch p := d.(type) {
+		case *int64:
+			*p = row[i].(int64)
+		case *string:
+			*p = row[i].(string)
+		case *interface{}:
+			*p = row[i]
+		default:
+			return fmt.Errorf("unsupported dest %T", d)
+		}
+	}
+
+	return nil
+}
+
+func (rows *scanRowsForTest) Err() error {
+	return nil
+}
+
+func ExampleStruct_ScanRows() {
+	type User struct {
+		ID   int64  `db:"id"`
+		Name string `db:"name"`
+	}
+
+	var userStruct = NewStruct(new(User))
+
+	// Columns are mapped by name. Unknown columns are skipped.
+	rows := &scanRowsForTest{
+		cols: []string{"name", "extra", "id"},
+		data: [][]interface{}{
+			{"Huan Du", "x", int64(1)},
+			{"Charmy Liu", "y", int64(2)},
+		},
+	}
+
+	var users []User
+
+	if err := userStruct.ScanRows(rows, &users); err != nil {
+		panic(err)
+	}
+
+	fmt.Println(users)
+
+	// Output:
+	// [{1 Huan Du} {2 Charmy Liu}]
+}
+
+func TestStructScanRows(t *testing.T) {
+	a := assert.New(t)
+	type User struct {
+		ID   int64  `db:"id"`
+		Name string `db:"name" fieldas:"user_name"`
+	}
+	st := NewStruct(new(User))
+
+	var users []*User
+	rows := &scanRowsForTest{
+		cols: []string{"user_name", "id"},
+		data: [][]interface{}{{"foo", int64(3)}},
+	}
+	a.NilError(st.ScanRows(rows, &users))
+	a.Equal(users, []*User{{ID: 3, Name: "foo"}})
+
+	var wrong []structContainsValuer
+	a.Equal(st.ScanRows(rows, &wrong), ErrScanInvalidDest)
+	a.Equal(st.ScanRows(rows, users), ErrScanInvalidDest)
+	a.Equal(st.ScanRows(rows, nil), ErrScanInvalidDest)
+
+	errScan := errors.New("scan error")
+	rows = &scanRowsForTest{
+		cols: []string{"id"},
+		data: [][]interface{}{{int64(1)}},
+		err:  errScan,
+	}
+	a.Equal(st.ScanRows(rows, &users), errScan)
+}
+
+func ExampleStruct_ScanRow() {
+	type User struct {
+		ID   int64  `db:"id"`
+		Name string `db:"name"`
+	}
+
+	var userStruct = NewStruct(new(User))
+
+	// The SELECT column order is different from the struct field order.
+	rows := &scanRowsForTest{
+		cols: []string{"name", "id"},
+		data: [][]interface{}{
+			{"Huan Du", int64(1)},
+		},
+	}
+
+	var user User
+
+	for rows.Next() {
+		if err := userStruct.ScanRow(rows, &user); err != nil {
+			panic(err)
+		}
+	}
+
+	fmt.Println(user)
+
+	// Output:
+	// {1 Huan Du}
+}
+
+func TestStructScanRow(t *testing.T) {
+	a := assert.New(t)
+	type User struct {
+		ID   int64  `db:"id"`
+		Name string `db:"name"`
+	}
+	st := NewStruct(new(User))
+	rows := &scanRowsForTest{
+		cols: []string{"unknown", "id"},
+		data: [][]interface{}{{"foo", int64(3)}},
+	}
+	a.Assert(rows.Next())
+
+	var user User
+	a.NilError(st.ScanRow(rows, &user))
+	a.Equal(user, User{ID: 3})
+
+	a.Equal(st.ScanRow(rows, user), ErrScanInvalidDest)
+	a.Equal(st.ScanRow(rows, &structContainsValuer{}), ErrScanInvalidDest)
+	a.Equal(emptyStruct.ScanRow(rows, &user), ErrScanInvalidDest)
+}
@@ -4,6 +4,7 @@
 package sqlbuilder
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -17,6 +18,7 @@ const (
 	selectMarkerAfterJoin
 	selectMarkerAfterWhere
 	selectMarkerAfterGroupBy
+	selectMarkerAfterWindow
 	selectMarkerAfterOrderBy
 	selectMarkerAfterLimit
 	selectMarkerAfterFor
@@ -27,6 +29,7 @@ type JoinOption string
 
 // Join options.
 const (
+	CrossJoin      JoinOption = "CROSS"
 	FullJoin       JoinOption = "FULL"
 	FullOuterJoin  JoinOption = "FULL OUTER"
 	InnerJoin      JoinOption = "INNER"
@@ -34,6 +37,65 @@ const (
 	LeftOuterJoin  JoinOption = "LEFT OUTER"
 	RightJoin      JoinOption = "RIGHT"
 	RightOuterJoin JoinOption = "RIGHT OUTER"
+
+	NaturalJoin      JoinOption = "NATURAL"
+	NaturalLeftJoin  JoinOption = "NATURAL LEFT"
+	NaturalRightJoin JoinOption = "NATURAL RIGHT"
+)
+
+// hasJoinCondition returns true if the JOIN with option accepts ON conditions.
+func (option JoinOption) hasJoinCondition() bool {
+	switch option {
+	case CrossJoin, NaturalJoin, NaturalLeftJoin, NaturalRightJoin:
+		return false
+	}
+
+	return true
+}
+
+// IndexHint is an index hint for a table, e.g. "USE INDEX (idx)" in MySQL.
+// Use `UseIndex`, `ForceIndex` or `IgnoreIndex` to create it.
+type IndexHint struct {
+	// Action is one of "USE", "FORCE" and "IGNORE".
+	Action string
+
+	// Indexes is the list of index names.
+	Indexes []string
+}
+
+// UseIndex creates an index hint "USE INDEX (index...)".
+func UseIndex(index ...string) IndexHint {
+	return IndexHint{Action: "USE", Indexes: index}
+}
+
+// ForceIndex creates an index hint "FORCE INDEX (index...)".
+func ForceIndex(index ...string) IndexHint {
+	return IndexHint{Action: "FORCE", Indexes: index}
+}
+
+// IgnoreIndex creates an index hint "IGNORE INDEX (index...)".
+func IgnoreIndex(index ...string) IndexHint {
+	return IndexHint{Action: "IGNORE", Indexes: index}
+}
+
+// SampleMethod is the sampling method in TABLESAMPLE.
+type SampleMethod string
+
+// Sample methods.
+const (
+	System    SampleMethod = "SYSTEM"
+	Bernoulli SampleMethod = "BERNOULLI"
+)
+
+// OrderOption is the option of a column in ORDER BY.
+type OrderOption string
+
+// Order options.
+const (
+	Ascending  OrderOption = "ASC"
+	Descending OrderOption = "DESC"
+	NullsFirst OrderOption = "NULLS FIRST"
+	NullsLast  OrderOption = "NULLS LAST"
 )
 
 // NewSelectBuilder creates a new SELECT builder.
@@ -42,12 +104,19 @@ func NewSelectBuilder() *SelectBuilder {
 }
 
 func newSelectBuilder() *SelectBuilder {
-	args := &Args{}
+	return newSelectBuilderWithArgs(&Args{})
+}
+
+func newSelectBuilderWithArgs(args *Args) *SelectBuilder {
 	proxy := &whereClauseProxy{}
+	havingProxy := &havingClauseProxy{}
 	return &SelectBuilder{
 		whereClauseProxy: proxy,
 		whereClauseExpr:  args.Add(proxy),
 
+		havingClauseProxy: havingProxy,
+		havingClauseExpr:  args.Add(havingProxy),
+
 		Cond: Cond{
 			Args: args,
 		},
@@ -61,27 +130,44 @@ func newSelectBuilder() *SelectBuilder {
 // SelectBuilder is a builder to build SELECT.
 type SelectBuilder struct {
 	*WhereClause
+	*HavingClause
 	Cond
 
 	whereClauseProxy *whereClauseProxy
 	whereClauseExpr  string
 
+	havingClauseProxy *havingClauseProxy
+	havingClauseExpr  string
+
 	cteBuilderVar string
 	cteBuilder    *CTEBuilder
 
 	distinct    bool
+	distinctOn  []string
 	tables      []string
+	systemTime  string
+	tableSample bool
+	lateral     bool
 	selectCols  []string
 	joinOptions []JoinOption
 	joinTables  []string
 	joinExprs   [][]string
-	havingExprs []string
 	groupByCols []string
+	groupByAll  bool
+	withRollup  bool
+	windowNames []string
+	windowSpecs []WindowSpec
 	orderByCols []string
+	orderNulls  bool
+	orderByRefs map[string]string
 	order       string
 	limit       int
+	limitVar    string
 	offset      int
+	offsetVar   string
 	forWhat     string
+	forOf       []string
+	forWait     string
 
 	args *Args
 
@@ -96,6 +182,16 @@ func Select(col ...string) *SelectBuilder {
 	return DefaultFlavor.NewSelectBuilder().Select(col...)
 }
 
+// SubQuery returns a new SELECT builder sharing the args of sb.
+// It's useful to build a correlated subquery which refers values bound in sb,
+// as placeholders in both builders are allocated from one sequence.
+// The returned builder can be used in sb through `Var`, `BuilderAs`, `ExistsQuery`, etc.
+//
+// As sb and the returned builder share the same args, they must not be used concurrently.
+func (sb *SelectBuilder) SubQuery() *SelectBuilder {
+	return newSelectBuilderWithArgs(sb.args)
+}
+
 // TableNames returns all table names in this SELECT statement.
 func (sb *SelectBuilder) TableNames() []string {
 	var additionalTableNames []string
@@ -119,6 +215,12 @@ func (sb *SelectBuilder) TableNames() []string {
 	return tableNames
 }
 
+// NumTables returns the number of tables in FROM.
+// Tables added by CTE are counted, which is consistent with `TableNames` and the built SQL.
+func (sb *SelectBuilder) NumTables() int {
+	return len(sb.TableNames())
+}
+
 // With sets WITH clause (the Common Table Expression) before SELECT.
 func (sb *SelectBuilder) With(builder *CTEBuilder) *SelectBuilder {
 	sb.marker = selectMarkerAfterWith
@@ -134,6 +236,12 @@ func (sb *SelectBuilder) Select(col ...string) *SelectBuilder {
 	return sb
 }
 
+// SelectExpr sets columns in SELECT like `Select`.
+// A string in col is used as it is and an `Expression` is written with its args bound.
+func (sb *SelectBuilder) SelectExpr(col ...interface{}) *SelectBuilder {
+	return sb.Select(exprStrings(sb.args, col)...)
+}
+
 // SelectMore adds more columns in SELECT.
 func (sb *SelectBuilder) SelectMore(col ...string) *SelectBuilder {
 	sb.selectCols = append(sb.selectCols, col...)
@@ -141,6 +249,114 @@ func (sb *SelectBuilder) SelectMore(col ...string) *SelectBuilder {
 	return sb
 }
 
+// Count replaces columns in SELECT with "COUNT(col)".
+// FROM, JOIN, WHERE and GROUP BY are kept as they are.
+// If col is empty, "COUNT(*)" is used.
+func (sb *SelectBuilder) Count(col string) *SelectBuilder {
+	if col == "" {
+		col = "*"
+	}
+
+	return sb.Select("COUNT(" + col + ")")
+}
+
+// ClearSelect removes all columns in SELECT.
+// DISTINCT and DISTINCT ON are not changed.
+func (sb *SelectBuilder) ClearSelect() *SelectBuilder {
+	sb.selectCols = nil
+	return sb
+}
+
+// CountDistinct replaces columns in SELECT with "COUNT(DISTINCT col...)".
+// FROM, JOIN, WHERE and GROUP BY are kept as they are.
+func (sb *SelectBuilder) CountDistinct(col ...string) *SelectBuilder {
+	return sb.Select("COUNT(DISTINCT " + strings.Join(col, ", ") + ")")
+}
+
+// AsCountQuery returns a new SELECT builder counting rows returned by sb, e.g.
+//
+//	SELECT COUNT(*) FROM (sb) AS t
+//
+// It's useful to count total rows of an arbitrary query for pagination.
+// The sb is wrapped as it is, so remove LIMIT and OFFSET in sb if the total count is expected.
+// The new builder has the same flavor as sb.
+func (sb *SelectBuilder) AsCountQuery() *SelectBuilder {
+	cb := sb.args.Flavor.NewSelectBuilder()
+	cb.Select("COUNT(*)").From(cb.BuilderAs(sb, "t"))
+	return cb
+}
+
+// TableSample returns a table expression sampling percentage of rows in table.
+// The table can have an alias, e.g. "users u". The returned expression can be used in `From` or `Join`.
+//
+// It's written as "table TABLESAMPLE method (percentage)" in PostgreSQL, Presto and ANSI,
+// "table TABLESAMPLE method (percentage%)" in DuckDB,
+// "table TABLESAMPLE SYSTEM (percentage PERCENT)" in SQLServer which only supports the System method,
+// and "table SAMPLE [BLOCK] (percentage) alias" in Oracle.
+// In other flavors, TABLESAMPLE is not supported and only the table is written.
+// `BuildWithFlavorStrict` reports it as an error wrapping ErrUnsupportedFlavor.
+func (sb *SelectBuilder) TableSample(table string, method SampleMethod, percentage float64) string {
+	p := strconv.FormatFloat(percentage, 'g', -1, 64)
+	sb.tableSample = true
+
+	return sb.Var(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			switch ctx.Flavor {
+			case PostgreSQL, Presto, ANSI:
+				ctx.WriteString(table)
+				ctx.WriteString(" TABLESAMPLE ")
+				ctx.WriteString(string(method))
+				ctx.WriteString(" (")
+				ctx.WriteString(p)
+				ctx.WriteString(")")
+
+			case DuckDB:
+				ctx.WriteString(table)
+				ctx.WriteString(" TABLESAMPLE ")
+				ctx.WriteString(string(method))
+				ctx.WriteString(" (")
+				ctx.WriteString(p)
+				ctx.WriteString("%)")
+
+			case SQLServer:
+				ctx.WriteString(table)
+				ctx.WriteString(" TABLESAMPLE SYSTEM (")
+				ctx.WriteString(p)
+				ctx.WriteString(" PERCENT)")
+
+			case Oracle:
+				// The alias must be written after the sample clause in Oracle.
+				name, alias := splitTableAlias(table)
+
+				ctx.WriteString(name)
+				ctx.WriteString(" SAMPLE ")
+
+				if method == System {
+					ctx.WriteString("BLOCK ")
+				}
+
+				ctx.WriteString("(")
+				ctx.WriteString(p)
+				ctx.WriteString(")")
+				ctx.WriteString(alias)
+
+			default:
+				ctx.WriteString(table)
+			}
+		},
+	})
+}
+
+// splitTableAlias splits table into the table name and the alias with leading spaces,
+// e.g. "users u" is split into "users" and " u".
+func splitTableAlias(table string) (name, alias string) {
+	if i := strings.IndexAny(table, " \t\n"); i >= 0 {
+		return table[:i], table[i:]
+	}
+
+	return table, ""
+}
+
 // Distinct marks this SELECT as DISTINCT.
 func (sb *SelectBuilder) Distinct() *SelectBuilder {
 	sb.distinct = true
@@ -148,6 +364,71 @@ func (sb *SelectBuilder) Distinct() *SelectBuilder {
 	return sb
 }
 
+// DistinctOn marks this SELECT as DISTINCT ON (col...) in PostgreSQL and DuckDB.
+// It falls back to plain DISTINCT in other flavors and `BuildWithFlavorStrict` reports
+// an error wrapping ErrUnsupportedFlavor.
+//
+// PostgreSQL requires DISTINCT ON expressions to match the leading ORDER BY expressions.
+// ORDER BY is written as it is. If it's set and doesn't start with cols in PostgreSQL,
+// `BuildWithFlavorStrict` reports an error wrapping ErrDistinctOnMismatch.
+func (sb *SelectBuilder) DistinctOn(col ...string) *SelectBuilder {
+	sb.distinct = true
+	sb.distinctOn = col
+	sb.marker = selectMarkerAfterSelect
+	return sb
+}
+
+// missingDistinctOnCols returns DISTINCT ON columns missing in the leading ORDER BY columns.
+func (sb *SelectBuilder) missingDistinctOnCols() []string {
+	if len(sb.orderByCols) == 0 {
+		return nil
+	}
+
+	leading := sb.orderByCols
+
+	if len(leading) > len(sb.distinctOn) {
+		leading = leading[:len(sb.distinctOn)]
+	}
+
+	var missing []string
+
+	for _, col := range sb.distinctOn {
+		found := false
+
+		for _, orderBy := range leading {
+			if name, ok := sb.orderByRefs[orderBy]; ok {
+				orderBy = name
+			}
+
+			if trimOrderDirection(orderBy) == col {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			missing = append(missing, col)
+		}
+	}
+
+	return missing
+}
+
+// trimOrderDirection removes trailing direction and NULLS ordering in an ORDER BY expression.
+func trimOrderDirection(orderBy string) string {
+	orderBy = strings.TrimSpace(orderBy)
+	upper := strings.ToUpper(orderBy)
+
+	for _, suffix := range []string{" NULLS FIRST", " NULLS LAST", " ASC", " DESC"} {
+		if strings.HasSuffix(upper, suffix) {
+			orderBy = strings.TrimSpace(orderBy[:len(orderBy)-len(suffix)])
+			upper = upper[:len(orderBy)]
+		}
+	}
+
+	return orderBy
+}
+
 // From sets table names in SELECT.
 func (sb *SelectBuilder) From(table ...string) *SelectBuilder {
 	sb.tables = table
@@ -155,6 +436,74 @@ func (sb *SelectBuilder) From(table ...string) *SelectBuilder {
 	return sb
 }
 
+// FromWithHint sets a table with an index hint in FROM.
+// The table can have an alias, e.g. "users u", and the hint is written after it.
+//
+// The hint is written as "USE INDEX (idx)", "FORCE INDEX (idx)" or "IGNORE INDEX (idx)" in MySQL,
+// and "WITH (INDEX(idx))" for USE and FORCE in SQLServer.
+// It's ignored in other flavors.
+func (sb *SelectBuilder) FromWithHint(table string, hint IndexHint) *SelectBuilder {
+	return sb.From(sb.tableWithHint(table, hint))
+}
+
+// JoinWithHint sets expressions of JOIN with an option and an index hint on the joined table.
+// See doc in `JoinWithOption` and `FromWithHint` for details.
+func (sb *SelectBuilder) JoinWithHint(option JoinOption, table string, hint IndexHint, onExpr ...string) *SelectBuilder {
+	return sb.JoinWithOption(option, sb.tableWithHint(table, hint), onExpr...)
+}
+
+func (sb *SelectBuilder) tableWithHint(table string, hint IndexHint) string {
+	indexes := strings.Join(hint.Indexes, ", ")
+
+	return sb.Var(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			ctx.WriteString(table)
+
+			switch ctx.Flavor {
+			case MySQL, MariaDB:
+				ctx.WriteString(" ")
+				ctx.WriteString(hint.Action)
+				ctx.WriteString(" INDEX (")
+				ctx.WriteString(indexes)
+				ctx.WriteString(")")
+
+			case SQLServer:
+				if hint.Action == "IGNORE" {
+					return
+				}
+
+				ctx.WriteString(" WITH (INDEX(")
+				ctx.WriteString(indexes)
+				ctx.WriteString("))")
+			}
+		},
+	})
+}
+
+// ForSystemTimeAsOf queries a system-versioned temporal table at the point in time t.
+// It's written as "FROM table FOR SYSTEM_TIME AS OF t" after the first table in FROM.
+//
+// It's supported by SQLServer, MariaDB and ANSI.
+// The alias of the table, e.g. "u" in "users u", is written after FOR SYSTEM_TIME.
+// It's ignored in other flavors and `BuildWithFlavorStrict` reports an error wrapping ErrUnsupportedFlavor.
+func (sb *SelectBuilder) ForSystemTimeAsOf(t interface{}) *SelectBuilder {
+	sb.systemTime = "FOR SYSTEM_TIME AS OF " + sb.Var(t)
+	sb.marker = selectMarkerAfterFrom
+	return sb
+}
+
+// ForSystemTimeBetween queries a system-versioned temporal table between the point in time start and end.
+// It's written as "FROM table FOR SYSTEM_TIME BETWEEN start AND end" after the first table in FROM.
+//
+// It's supported by SQLServer, MariaDB and ANSI.
+// The alias of the table, e.g. "u" in "users u", is written after FOR SYSTEM_TIME.
+// It's ignored in other flavors and `BuildWithFlavorStrict` reports an error wrapping ErrUnsupportedFlavor.
+func (sb *SelectBuilder) ForSystemTimeBetween(start, end interface{}) *SelectBuilder {
+	sb.systemTime = "FOR SYSTEM_TIME BETWEEN " + sb.Var(start) + " AND " + sb.Var(end)
+	sb.marker = selectMarkerAfterFrom
+	return sb
+}
+
 // Join sets expressions of JOIN in SELECT.
 //
 // It builds a JOIN expression like
@@ -165,6 +514,74 @@ func (sb *SelectBuilder) Join(table string, onExpr ...string) *SelectBuilder {
 	return sb.JoinWithOption("", table, onExpr...)
 }
 
+// JoinOnEqual sets expressions of JOIN in SELECT with pairs of columns which must be equal.
+//
+// It builds a JOIN expression like
+//
+//	JOIN table ON pairs[0][0] = pairs[0][1] AND pairs[1][0] = pairs[1][1] ...
+//
+// All columns are escaped by `Escape`.
+func (sb *SelectBuilder) JoinOnEqual(table string, pairs ...[2]string) *SelectBuilder {
+	onExpr := make([]string, 0, len(pairs))
+
+	for _, pair := range pairs {
+		onExpr = append(onExpr, Escape(pair[0])+" = "+Escape(pair[1]))
+	}
+
+	return sb.Join(table, onExpr...)
+}
+
+// JoinUsing sets expressions of JOIN with an option and USING columns.
+//
+// It builds a JOIN expression like
+//
+//	option JOIN table USING (cols[0], cols[1], ...)
+//
+// The option can be empty to write a plain JOIN.
+func (sb *SelectBuilder) JoinUsing(option JoinOption, table string, cols ...string) *SelectBuilder {
+	return sb.JoinWithOption(option, table+" USING ("+strings.Join(cols, ", ")+")")
+}
+
+// CrossJoinLateral adds a lateral derived table in CROSS JOIN.
+//
+// It builds a JOIN expression like
+//
+//	CROSS JOIN LATERAL (sub) AS alias
+//
+// The sub can reference tables in FROM and previous JOINs.
+func (sb *SelectBuilder) CrossJoinLateral(sub Builder, alias string) *SelectBuilder {
+	return sb.JoinLateral(CrossJoin, sub, alias)
+}
+
+// LeftJoinLateral adds a lateral derived table in LEFT JOIN.
+//
+// It builds a JOIN expression like
+//
+//	LEFT JOIN LATERAL (sub) AS alias ON onExpr[0] AND onExpr[1] ...
+//
+// As ON is required by LEFT JOIN, "ON TRUE" is written if onExpr is empty.
+func (sb *SelectBuilder) LeftJoinLateral(sub Builder, alias string, onExpr ...string) *SelectBuilder {
+	return sb.JoinLateral(LeftJoin, sub, alias, onExpr...)
+}
+
+// JoinLateral adds a lateral derived table in JOIN with an option.
+//
+// It builds a JOIN expression like
+//
+//	option JOIN LATERAL (sub) AS alias ON onExpr[0] AND onExpr[1] ...
+//
+// If option requires a join condition and onExpr is empty, "ON TRUE" is written.
+// LATERAL is supported by PostgreSQL, MySQL 8.0.14+, Oracle, Presto and DuckDB.
+// The JOIN is written as it is in other flavors and `BuildWithFlavorStrict` reports it
+// as an error wrapping ErrUnsupportedFlavor.
+func (sb *SelectBuilder) JoinLateral(option JoinOption, sub Builder, alias string, onExpr ...string) *SelectBuilder {
+	if len(onExpr) == 0 && option.hasJoinCondition() {
+		onExpr = []string{"TRUE"}
+	}
+
+	return sb.JoinWithOption(option, sb.LateralAs(sub, alias), onExpr...)
+}
+
 // JoinWithOption sets expressions of JOIN with an option.
 //
 // It builds a JOIN expression like
@@ -172,6 +589,7 @@ func (sb *SelectBuilder) Join(table string, onExpr ...string) *SelectBuilder {
 //	option JOIN table ON onExpr[0] AND onExpr[1] ...
 //
 // Here is a list of supported options.
+//   - CrossJoin: CROSS JOIN
 //   - FullJoin: FULL JOIN
 //   - FullOuterJoin: FULL OUTER JOIN
 //   - InnerJoin: INNER JOIN
@@ -179,6 +597,11 @@ func (sb *SelectBuilder) Join(table string, onExpr ...string) *SelectBuilder {
 //   - LeftOuterJoin: LEFT OUTER JOIN
 //   - RightJoin: RIGHT JOIN
 //   - RightOuterJoin: RIGHT OUTER JOIN
+//   - NaturalJoin: NATURAL JOIN
+//   - NaturalLeftJoin: NATURAL LEFT JOIN
+//   - NaturalRightJoin: NATURAL RIGHT JOIN
+//
+// CROSS JOIN and NATURAL JOIN don't have any join condition, so onExpr is ignored for them.
 func (sb *SelectBuilder) JoinWithOption(option JoinOption, table string, onExpr ...string) *SelectBuilder {
 	sb.joinOptions = append(sb.joinOptions, option)
 	sb.joinTables = append(sb.joinTables, table)
@@ -202,6 +625,54 @@ func (sb *SelectBuilder) Where(andExpr ...string) *SelectBuilder {
 	return sb
 }
 
+// WhereExpr sets expressions of WHERE in SELECT like `Where`.
+// A string in andExpr is used as it is and an `Expression` is written with its args bound.
+func (sb *SelectBuilder) WhereExpr(andExpr ...interface{}) *SelectBuilder {
+	return sb.Where(exprStrings(sb.args, andExpr)...)
+}
+
+// WhereNot negates every expression in notExpr as "NOT (expr)" and adds them to WHERE in SELECT.
+// Empty expressions are ignored.
+func (sb *SelectBuilder) WhereNot(notExpr ...string) *SelectBuilder {
+	andExpr := make([]string, 0, len(notExpr))
+
+	for _, expr := range notExpr {
+		if len(expr) == 0 {
+			continue
+		}
+
+		buf := newStringBuilder()
+		buf.Grow(len(opNOT) + len(expr) + 2)
+		buf.WriteString(opNOT)
+		buf.WriteRune('(')
+		buf.WriteString(expr)
+		buf.WriteRune(')')
+		andExpr = append(andExpr, buf.String())
+	}
+
+	return sb.Where(andExpr...)
+}
+
+// WhereNotEqual adds "field <> value" to WHERE in SELECT.
+func (sb *SelectBuilder) WhereNotEqual(field string, value interface{}) *SelectBuilder {
+	return sb.Where(sb.NotEqual(field, value))
+}
+
+// WhereNotIn adds "field NOT IN (value1, value2, ...)" to WHERE in SELECT.
+func (sb *SelectBuilder) WhereNotIn(field string, values ...interface{}) *SelectBuilder {
+	return sb.Where(sb.NotIn(field, values...))
+}
+
+// WhereNotLike adds "field NOT LIKE value" to WHERE in SELECT.
+func (sb *SelectBuilder) WhereNotLike(field string, value interface{}) *SelectBuilder {
+	return sb.Where(sb.NotLike(field, value))
+}
+
+// WhereNotBetween adds "field NOT BETWEEN lower AND upper" to WHERE in SELECT.
+func (sb *SelectBuilder) WhereNotBetween(field string, lower, upper interface{}) *SelectBuilder {
+	return sb.Where(sb.NotBetween(field, lower, upper))
+}
+
 // AddWhereClause adds all clauses in the whereClause to SELECT.
 func (sb *SelectBuilder) AddWhereClause(whereClause *WhereClause) *SelectBuilder {
 	if sb.WhereClause == nil {
@@ -214,11 +685,35 @@ func (sb *SelectBuilder) AddWhereClause(whereClause *WhereClause) *SelectBuilder
 
 // Having sets expressions of HAVING in SELECT.
 func (sb *SelectBuilder) Having(andExpr ...string) *SelectBuilder {
-	sb.havingExprs = append(sb.havingExprs, andExpr...)
+	if len(andExpr) == 0 || estimateStringsBytes(andExpr) == 0 {
+		return sb
+	}
+
+	if sb.HavingClause == nil {
+		sb.HavingClause = NewHavingClause()
+	}
+
+	sb.HavingClause.AddHavingExpr(sb.args, andExpr...)
 	sb.marker = selectMarkerAfterGroupBy
 	return sb
 }
 
+// HavingExpr sets expressions of HAVING in SELECT like `Having`.
+// A string in andExpr is used as it is and an `Expression` is written with its args bound.
+func (sb *SelectBuilder) HavingExpr(andExpr ...interface{}) *SelectBuilder {
+	return sb.Having(exprStrings(sb.args, andExpr)...)
+}
+
+// AddHavingClause adds all clauses in the havingClause to SELECT.
+func (sb *SelectBuilder) AddHavingClause(havingClause *HavingClause) *SelectBuilder {
+	if sb.HavingClause == nil {
+		sb.HavingClause = NewHavingClause()
+	}
+
+	sb.HavingClause.AddHavingClause(havingClause)
+	return sb
+}
+
 // GroupBy sets columns of GROUP BY in SELECT.
 func (sb *SelectBuilder) GroupBy(col ...string) *SelectBuilder {
 	sb.groupByCols = append(sb.groupByCols, col...)
@@ -226,6 +721,166 @@ func (sb *SelectBuilder) GroupBy(col ...string) *SelectBuilder {
 	return sb
 }
 
+// GroupByExpr sets columns of GROUP BY in SELECT like `GroupBy`.
+// A string in col is used as it is and an `Expression` is written with its args bound.
+func (sb *SelectBuilder) GroupByExpr(col ...interface{}) *SelectBuilder {
+	return sb.GroupBy(exprStrings(sb.args, col)...)
+}
+
+// GroupByRollup adds "ROLLUP(col...)" to GROUP BY in SELECT.
+//
+// In MySQL, cols are added to GROUP BY as they are and "WITH ROLLUP" is written after all columns.
+// As MySQL always rolls up the whole GROUP BY list, all columns set by `GroupBy` are rolled up as well.
+func (sb *SelectBuilder) GroupByRollup(col ...string) *SelectBuilder {
+	sb.withRollup = true
+	return sb.GroupBy(sb.Var(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			if ctx.Flavor.isMySQLCompatible() {
+				ctx.WriteStrings(col, ", ")
+				return
+			}
+
+			ctx.WriteString("ROLLUP(")
+			ctx.WriteStrings(col, ", ")
+			ctx.WriteString(")")
+		},
+	}))
+}
+
+// GroupByCube adds "CUBE(col...)" to GROUP BY in SELECT.
+func (sb *SelectBuilder) GroupByCube(col ...string) *SelectBuilder {
+	return sb.GroupBy("CUBE(" + strings.Join(col, ", ") + ")")
+}
+
+// GroupByGroupingSets adds "GROUPING SETS ((col...), ...)" to GROUP BY in SELECT.
+// An empty set is written as "()" which means the grand total.
+func (sb *SelectBuilder) GroupByGroupingSets(sets ...[]string) *SelectBuilder {
+	buf := newStringBuilder()
+	buf.WriteString("GROUPING SETS (")
+
+	for i, set := range sets {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+
+		buf.WriteRune('(')
+		buf.WriteStrings(set, ", ")
+		buf.WriteRune(')')
+	}
+
+	buf.WriteRune(')')
+	return sb.GroupBy(buf.String())
+}
+
+// GroupByAll groups by all non-aggregate columns in SELECT.
+//
+// It's written as "GROUP BY ALL" in ClickHouse and DuckDB.
+// In other flavors, it's expanded to a list of non-aggregate columns in SELECT.
+// A column is considered as an aggregate if it calls any well-known aggregate function, e.g. SUM or COUNT.
+// Columns like "*" and "t.*" are ignored in the expanded list.
+// Columns set by `GroupBy` are appended to the expanded list.
+func (sb *SelectBuilder) GroupByAll() *SelectBuilder {
+	sb.groupByAll = true
+	sb.marker = selectMarkerAfterGroupBy
+	return sb
+}
+
+// ClearGroupBy removes GROUP BY, including GROUP BY ALL and ROLLUP, in SELECT.
+// SQL added by `SQL` right after GROUP BY is removed as well.
+// HAVING is kept and will be written again once GROUP BY is set.
+func (sb *SelectBuilder) ClearGroupBy() *SelectBuilder {
+	sb.groupByCols = nil
+	sb.groupByAll = false
+	sb.withRollup = false
+	sb.injection.clear(selectMarkerAfterGroupBy)
+	return sb
+}
+
+// groupByAllCols returns all non-aggregate columns in SELECT.
+func (sb *SelectBuilder) groupByAllCols(flavor Flavor) []string {
+	cols := make([]string, 0, len(sb.selectCols))
+
+	for _, col := range sb.selectCols {
+		expr := parseColumnRef(col).Expr
+
+		if expr == "*" || strings.HasSuffix(expr, ".*") {
+			continue
+		}
+
+		if compiled, _ := sb.args.CompileWithFlavor(expr, flavor); isAggregateExpr(compiled) {
+			continue
+		}
+
+		cols = append(cols, expr)
+	}
+
+	return cols
+}
+
+// Window defines a named window in WINDOW clause.
+// If a window with the same name has been defined, it's replaced by spec.
+//
+// Use `OverWindow` to reference the named window in columns.
+// Flavors without WINDOW clause support, e.g. SQLServer and Informix,
+// don't write the WINDOW clause and `OverWindow` inlines the spec instead.
+func (sb *SelectBuilder) Window(name string, spec WindowSpec) *SelectBuilder {
+	sb.marker = selectMarkerAfterWindow
+
+	for i, n := range sb.windowNames {
+		if n == name {
+			sb.windowSpecs[i] = spec
+			return sb
+		}
+	}
+
+	sb.windowNames = append(sb.windowNames, name)
+	sb.windowSpecs = append(sb.windowSpecs, spec)
+	return sb
+}
+
+// OverWindow returns an expression "expr OVER name" referencing a named window defined by `Window`.
+// If the flavor doesn't support WINDOW clause, it's written as "expr OVER (spec)".
+func (sb *SelectBuilder) OverWindow(expr, name string) string {
+	return sb.Var(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			ctx.WriteString(expr)
+			ctx.WriteString(" OVER ")
+
+			if !ctx.Flavor.supportsWindowClause() {
+				for i, n := range sb.windowNames {
+					if n == name {
+						ctx.WriteString("(")
+						ctx.WriteString(sb.windowSpecs[i].String())
+						ctx.WriteString(")")
+						return
+					}
+				}
+			}
+
+			ctx.WriteString(name)
+		},
+	})
+}
+
+// Over returns an expression "expr OVER (PARTITION BY ... ORDER BY ... frame)" for window functions.
+// The optional frame is appended after ORDER BY, e.g. "ROWS BETWEEN 1 PRECEDING AND CURRENT ROW".
+//
+// Values bound by `Var` in expr, partitionBy, orderBy or frame are kept as args.
+func (sb *SelectBuilder) Over(expr string, partitionBy, orderBy []string, frame ...string) string {
+	spec := WindowSpec{
+		PartitionBy: partitionBy,
+		OrderBy:     orderBy,
+		Frame:       strings.Join(frame, " "),
+	}
+	return expr + " OVER (" + spec.String() + ")"
+}
+
+// WindowAs is an alias of `Window`.
+// It defines a named window which can be referenced by `OverWindow`.
+func (sb *SelectBuilder) WindowAs(name string, spec WindowSpec) *SelectBuilder {
+	return sb.Window(name, spec)
+}
+
 // OrderBy sets columns of ORDER BY in SELECT.
 func (sb *SelectBuilder) OrderBy(col ...string) *SelectBuilder {
 	sb.orderByCols = append(sb.orderByCols, col...)
@@ -233,6 +888,95 @@ func (sb *SelectBuilder) OrderBy(col ...string) *SelectBuilder {
 	return sb
 }
 
+// OrderByExpr sets columns of ORDER BY in SELECT like `OrderBy`.
+// A string in col is used as it is and an `Expression` is written with its args bound.
+func (sb *SelectBuilder) OrderByExpr(col ...interface{}) *SelectBuilder {
+	return sb.OrderBy(exprStrings(sb.args, col)...)
+}
+
+// orderByRef adds ref to ORDER BY and records col as the column of ref.
+func (sb *SelectBuilder) orderByRef(col, ref string) *SelectBuilder {
+	if sb.orderByRefs == nil {
+		sb.orderByRefs = map[string]string{}
+	}
+
+	sb.orderByRefs[ref] = col
+	return sb.OrderBy(ref)
+}
+
+// OrderByCollate adds a column with collation to ORDER BY in SELECT.
+// It's written as "col COLLATE collation" with optional "DESC".
+// The collation is quoted in PostgreSQL, e.g. `COLLATE "C"`, and written as it is in other flavors.
+func (sb *SelectBuilder) OrderByCollate(col, collation string, desc bool) *SelectBuilder {
+	return sb.orderByRef(col, sb.Var(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			ctx.WriteString(col)
+			writeCollation(ctx, collation)
+
+			if desc {
+				ctx.WriteString(" DESC")
+			}
+		},
+	}))
+}
+
+// OrderByCol adds a column to ORDER BY in SELECT with its own direction and NULLS ordering.
+// Calling it multiple times adds columns in order, e.g. "ORDER BY a ASC, b DESC NULLS LAST".
+//
+// NullsFirst and NullsLast are written as they are in flavors supporting them.
+// In MySQL, SQLServer and Informix, they are emulated by ordering by
+// "CASE WHEN col IS NULL THEN 0 ELSE 1 END" before the column.
+// In CQL, they are dropped and `BuildWithFlavorStrict` reports an error wrapping ErrUnsupportedFlavor.
+func (sb *SelectBuilder) OrderByCol(col string, opts ...OrderOption) *SelectBuilder {
+	var direction, nulls OrderOption
+
+	for _, opt := range opts {
+		switch opt {
+		case Ascending, Descending:
+			direction = opt
+		case NullsFirst, NullsLast:
+			nulls = opt
+			sb.orderNulls = true
+		}
+	}
+
+	return sb.orderByRef(col, sb.Var(condBuilder{
+		Builder: func(ctx *argsCompileContext) {
+			nulls := nulls
+
+			if nulls != "" {
+				switch ctx.Flavor {
+				case MySQL, MariaDB, SQLServer, Informix:
+					ctx.WriteString("CASE WHEN ")
+					ctx.WriteString(col)
+
+					if nulls == NullsFirst {
+						ctx.WriteString(" IS NULL THEN 0 ELSE 1 END, ")
+					} else {
+						ctx.WriteString(" IS NULL THEN 1 ELSE 0 END, ")
+					}
+
+					nulls = ""
+				case CQL:
+					nulls = ""
+				}
+			}
+
+			ctx.WriteString(col)
+
+			if direction != "" {
+				ctx.WriteString(" ")
+				ctx.WriteString(string(direction))
+			}
+
+			if nulls != "" {
+				ctx.WriteString(" ")
+				ctx.WriteString(string(nulls))
+			}
+		},
+	}))
+}
+
 // Asc sets order of ORDER BY to ASC.
 func (sb *SelectBuilder) Asc() *SelectBuilder {
 	sb.order = "ASC"
@@ -247,9 +991,34 @@ func (sb *SelectBuilder) Desc() *SelectBuilder {
 	return sb
 }
 
+// ClearOrderBy removes ORDER BY and the order set by `Asc` or `Desc` in SELECT.
+// SQL added by `SQL` right after ORDER BY is removed as well.
+func (sb *SelectBuilder) ClearOrderBy() *SelectBuilder {
+	sb.orderByCols = nil
+	sb.order = ""
+	sb.injection.clear(selectMarkerAfterOrderBy)
+	return sb
+}
+
 // Limit sets the LIMIT in SELECT.
 func (sb *SelectBuilder) Limit(limit int) *SelectBuilder {
 	sb.limit = limit
+	sb.limitVar = ""
+	sb.marker = selectMarkerAfterLimit
+	return sb
+}
+
+// LimitVar sets the LIMIT in SELECT like `Limit`.
+// Unlike `Limit`, limit is bound as an argument instead of being written as a literal,
+// so that the compiled SQL is the same for different limits.
+func (sb *SelectBuilder) LimitVar(limit int) *SelectBuilder {
+	sb.limit = limit
+	sb.limitVar = ""
+
+	if limit >= 0 {
+		sb.limitVar = sb.args.Add(limit)
+	}
+
 	sb.marker = selectMarkerAfterLimit
 	return sb
 }
@@ -257,10 +1026,52 @@ func (sb *SelectBuilder) Limit(limit int) *SelectBuilder {
 // Offset sets the LIMIT offset in SELECT.
 func (sb *SelectBuilder) Offset(offset int) *SelectBuilder {
 	sb.offset = offset
+	sb.offsetVar = ""
+	sb.marker = selectMarkerAfterLimit
+	return sb
+}
+
+// OffsetVar sets the LIMIT offset in SELECT like `Offset`.
+// Unlike `Offset`, offset is bound as an argument instead of being written as a literal.
+func (sb *SelectBuilder) OffsetVar(offset int) *SelectBuilder {
+	sb.offset = offset
+	sb.offsetVar = ""
+
+	if offset >= 0 {
+		sb.offsetVar = sb.args.Add(offset)
+	}
+
 	sb.marker = selectMarkerAfterLimit
 	return sb
 }
 
+// ClearLimitOffset removes LIMIT and OFFSET in SELECT.
+// SQL added by `SQL` right after LIMIT is removed as well.
+func (sb *SelectBuilder) ClearLimitOffset() *SelectBuilder {
+	sb.limit = -1
+	sb.limitVar = ""
+	sb.offset = -1
+	sb.offsetVar = ""
+	sb.injection.clear(selectMarkerAfterLimit)
+	return sb
+}
+
+func (sb *SelectBuilder) limitString() string {
+	if sb.limitVar != "" {
+		return sb.limitVar
+	}
+
+	return strconv.Itoa(sb.limit)
+}
+
+func (sb *SelectBuilder) offsetString() string {
+	if sb.offsetVar != "" {
+		return sb.offsetVar
+	}
+
+	return strconv.Itoa(sb.offset)
+}
+
 // ForUpdate adds FOR UPDATE at the end of SELECT statement.
 func (sb *SelectBuilder) ForUpdate() *SelectBuilder {
 	sb.forWhat = "UPDATE"
@@ -275,6 +1086,60 @@ func (sb *SelectBuilder) ForShare() *SelectBuilder {
 	return sb
 }
 
+// Of sets tables locked by FOR UPDATE or FOR SHARE, e.g. "FOR UPDATE OF orders".
+//
+// It's only written in MySQL, PostgreSQL and Oracle and omitted in other flavors.
+func (sb *SelectBuilder) Of(table ...string) *SelectBuilder {
+	sb.forOf = append(sb.forOf, table...)
+	sb.marker = selectMarkerAfterFor
+	return sb
+}
+
+// SkipLocked adds SKIP LOCKED after FOR UPDATE or FOR SHARE.
+//
+// It's only written in MySQL, PostgreSQL and Oracle and omitted in other flavors.
+func (sb *SelectBuilder) SkipLocked() *SelectBuilder {
+	sb.forWait = "SKIP LOCKED"
+	sb.marker = selectMarkerAfterFor
+	return sb
+}
+
+// NoWait adds NOWAIT after FOR UPDATE or FOR SHARE.
+//
+// It's only written in MySQL, PostgreSQL and Oracle and omitted in other flavors.
+func (sb *SelectBuilder) NoWait() *SelectBuilder {
+	sb.forWait = "NOWAIT"
+	sb.marker = selectMarkerAfterFor
+	return sb
+}
+
+// SelectSubquery adds a scalar subquery as a column in SELECT.
+// It's written as "(subquery) AS alias" and args in subquery are merged into sb.
+//
+// The subquery can reference tables in the outer query by name or alias,
+// which is known as a correlated scalar subquery.
+//
+//	sub := Select("COUNT(*)").From("orders o").Where("o.user_id = u.id")
+//	sb := Select("u.id").SelectSubquery(sub, "order_count").From("users u")
+//	// SELECT u.id, (SELECT COUNT(*) FROM orders o WHERE o.user_id = u.id) AS order_count FROM users u
+func (sb *SelectBuilder) SelectSubquery(sub Builder, alias string) *SelectBuilder {
+	return sb.SelectMore(sb.BuilderAs(sub, alias))
+}
+
+// UnionWith creates a new UnionBuilder to union sb and builders together using UNION operator.
+// The flavor of the UnionBuilder is the same as sb.
+// Call `UnionBuilder#Add` to add more builders to the union.
+func (sb *SelectBuilder) UnionWith(builders ...Builder) *UnionBuilder {
+	return sb.args.Flavor.NewUnionBuilder().Union(append([]Builder{sb}, builders...)...)
+}
+
+// UnionAllWith creates a new UnionBuilder to union sb and builders together using UNION ALL operator.
+// The flavor of the UnionBuilder is the same as sb.
+// Call `UnionBuilder#Add` to add more builders to the union.
+func (sb *SelectBuilder) UnionAllWith(builders ...Builder) *UnionBuilder {
+	return sb.args.Flavor.NewUnionBuilder().UnionAll(append([]Builder{sb}, builders...)...)
+}
+
 // As returns an AS expression.
 func (sb *SelectBuilder) As(name, alias string) string {
 	return fmt.Sprintf("%s AS %s", name, alias)
@@ -287,15 +1152,53 @@ func (sb *SelectBuilder) BuilderAs(builder Builder, alias string) string {
 }
 
 // LateralAs returns a LATERAL derived table expression wrapping a complex SQL.
+// See `JoinLateral` for flavors supporting LATERAL.
 func (sb *SelectBuilder) LateralAs(builder Builder, alias string) string {
+	sb.lateral = true
 	return fmt.Sprintf("LATERAL (%s) AS %s", sb.Var(builder), alias)
 }
 
+// Columns returns all columns in the select list with parsed alias.
+// Nested builders and values in columns are compiled with the flavor of sb,
+// so that the Expr in ColumnRef is the same as the one in the built SQL.
+func (sb *SelectBuilder) Columns() []ColumnRef {
+	cols := make([]ColumnRef, 0, len(sb.selectCols))
+
+	for _, col := range sb.selectCols {
+		expr, _ := sb.args.CompileWithFlavor(col, sb.args.Flavor)
+		cols = append(cols, parseColumnRef(expr))
+	}
+
+	return cols
+}
+
 // NumCol returns the number of columns to select.
 func (sb *SelectBuilder) NumCol() int {
 	return len(sb.selectCols)
 }
 
+// NumJoin returns the number of joined tables.
+func (sb *SelectBuilder) NumJoin() int {
+	return len(sb.joinTables)
+}
+
+// NumWhere returns the number of expressions in WHERE.
+// Non-empty expressions in all clauses of the WhereClause are counted, including clauses joined by OR.
+func (sb *SelectBuilder) NumWhere() int {
+	return sb.WhereClause.numExprs()
+}
+
+// NumGroupBy returns the number of columns in GROUP BY.
+// GROUP BY ALL is not counted.
+func (sb *SelectBuilder) NumGroupBy() int {
+	return len(sb.groupByCols)
+}
+
+// NumOrderBy returns the number of columns in ORDER BY.
+func (sb *SelectBuilder) NumOrderBy() int {
+	return len(sb.orderByCols)
+}
+
 // String returns the compiled SELECT string.
 func (sb *SelectBuilder) String() string {
 	s, _ := sb.Build()
@@ -308,9 +1211,142 @@ func (sb *SelectBuilder) Build() (sql string, args []interface{}) {
 	return sb.BuildWithFlavor(sb.args.Flavor)
 }
 
+// BuildContext returns compiled SELECT string and args like `Build`.
+// If `OnBuild` is set, it's called with ctx and the compiled SQL.
+func (sb *SelectBuilder) BuildContext(ctx context.Context) (sql string, args []interface{}) {
+	sql, args = sb.Build()
+
+	if OnBuild != nil {
+		OnBuild(ctx, sql, "SELECT")
+	}
+
+	return
+}
+
+// BuildPretty returns formatted SELECT string and args.
+// The args are the same as the ones returned by `Build`.
+func (sb *SelectBuilder) BuildPretty(opts FormatOptions) (sql string, args []interface{}) {
+	return sb.build(sb.args.Flavor, &opts)
+}
+
+// BuildE returns compiled SELECT string and args like `Build`.
+// Unlike `Build`, it returns an error if the SELECT is invalid, e.g. an expression built by
+// a Cond not sharing args with the builder is used. See `BuildWithFlavorStrict` for details.
+//
+// The error names the offending expression and its clause if it's found in the builder.
+func (sb *SelectBuilder) BuildE() (sql string, args []interface{}, err error) {
+	return sb.BuildWithFlavorStrict(sb.args.Flavor)
+}
+
+// BuildWithFlavorStrict works like `BuildWithFlavor` and validates the compiled SELECT.
+// It returns an error wrapping ErrInvalidArg if any placeholder cannot be resolved,
+// or an error wrapping ErrUnsupportedFlavor if any clause would be omitted in flavor.
+func (sb *SelectBuilder) BuildWithFlavorStrict(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}, err error) {
+	return buildStrict(sb, flavor, initialArg...)
+}
+
+func (sb *SelectBuilder) checkFlavor(flavor Flavor) error {
+	if sb.forWhat != "" {
+		switch flavor {
+		case MySQL, MariaDB, PostgreSQL, Oracle:
+		default:
+			if len(sb.forOf) > 0 {
+				return unsupportedFlavorError("FOR "+sb.forWhat+" OF", flavor)
+			}
+
+			if sb.forWait != "" {
+				return unsupportedFlavorError("FOR "+sb.forWhat+" "+sb.forWait, flavor)
+			}
+		}
+	}
+
+	if sb.systemTime != "" {
+		switch flavor {
+		case SQLServer, MariaDB, ANSI:
+		default:
+			return unsupportedFlavorError("FOR SYSTEM_TIME", flavor)
+		}
+	}
+
+	if len(sb.distinctOn) > 0 && flavor != PostgreSQL && flavor != DuckDB {
+		return unsupportedFlavorError("DISTINCT ON", flavor)
+	}
+
+	if len(sb.distinctOn) > 0 && flavor == PostgreSQL {
+		if missing := sb.missingDistinctOnCols(); len(missing) > 0 {
+			return fmt.Errorf("%w: %s not in leading ORDER BY", ErrDistinctOnMismatch, strings.Join(missing, ", "))
+		}
+	}
+
+	if sb.tableSample {
+		switch flavor {
+		case PostgreSQL, Presto, ANSI, DuckDB, SQLServer, Oracle:
+		default:
+			return unsupportedFlavorError("TABLESAMPLE", flavor)
+		}
+	}
+
+	if sb.lateral {
+		switch flavor {
+		case PostgreSQL, MySQL, Oracle, Presto, DuckDB:
+		default:
+			return unsupportedFlavorError("LATERAL", flavor)
+		}
+	}
+
+	switch flavor {
+	case CQL:
+		if sb.offset >= 0 {
+			return unsupportedFlavorError("OFFSET", flavor)
+		}
+
+		if sb.orderNulls {
+			return unsupportedFlavorError("NULLS FIRST/LAST", flavor)
+		}
+
+	case Informix:
+		if sb.offset >= 0 && sb.limit <= 0 {
+			return unsupportedFlavorError("OFFSET without LIMIT", flavor)
+		}
+	}
+
+	return nil
+}
+
+func (sb *SelectBuilder) listExprs() []exprGroup {
+	joinExprs := make([]string, 0, len(sb.joinTables))
+
+	for _, exprs := range sb.joinExprs {
+		joinExprs = append(joinExprs, exprs...)
+	}
+
+	groups := []exprGroup{
+		{clause: "SELECT", args: sb.args, exprs: sb.selectCols},
+		{clause: "FROM", args: sb.args, exprs: sb.tables},
+		{clause: "JOIN", args: sb.args, exprs: sb.joinTables},
+		{clause: "JOIN", args: sb.args, exprs: joinExprs},
+	}
+
+	if sb.WhereClause != nil {
+		groups = append(groups, clauseExprGroups("WHERE", sb.WhereClause.clauses)...)
+	}
+
+	groups = append(groups, exprGroup{clause: "GROUP BY", args: sb.args, exprs: sb.groupByCols})
+
+	if sb.HavingClause != nil {
+		groups = append(groups, clauseExprGroups("HAVING", sb.HavingClause.clauses)...)
+	}
+
+	return append(groups, exprGroup{clause: "ORDER BY", args: sb.args, exprs: sb.orderByCols})
+}
+
 // BuildWithFlavor returns compiled SELECT string and args with flavor and initial args.
 // They can be used in `DB#Query` of package `database/sql` directly.
 func (sb *SelectBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	return sb.build(flavor, nil, initialArg...)
+}
+
+func (sb *SelectBuilder) build(flavor Flavor, opts *FormatOptions, initialArg ...interface{}) (sql string, args []interface{}) {
 	buf := newStringBuilder()
 	sb.injection.WriteTo(buf, selectMarkerInit)
 
@@ -322,10 +1358,16 @@ func (sb *SelectBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 	}
 
 	if len(sb.selectCols) > 0 {
-		buf.WriteLeadingString("SELECT ")
+		opts.writeClause(buf, false, "SELECT ")
 
 		if sb.distinct {
 			buf.WriteString("DISTINCT ")
+
+			if len(sb.distinctOn) > 0 && (flavor == PostgreSQL || flavor == DuckDB) {
+				buf.WriteString("ON (")
+				buf.WriteStrings(sb.distinctOn, ", ")
+				buf.WriteString(") ")
+			}
 		}
 
 		if oraclePage {
@@ -376,21 +1418,46 @@ func (sb *SelectBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 	tableNames := sb.TableNames()
 
 	if len(tableNames) > 0 {
-		buf.WriteLeadingString("FROM ")
-		buf.WriteStrings(tableNames, ", ")
+		opts.writeClause(buf, false, "FROM ")
+
+		switch flavor {
+		case MariaDB, SQLServer, ANSI:
+			if sb.systemTime != "" {
+				// The alias must be written after FOR SYSTEM_TIME.
+				name, alias := splitTableAlias(tableNames[0])
+				buf.WriteString(name)
+				buf.WriteRune(' ')
+				buf.WriteString(sb.systemTime)
+				buf.WriteString(alias)
+
+				if len(tableNames) > 1 {
+					buf.WriteString(", ")
+					buf.WriteStrings(tableNames[1:], ", ")
+				}
+
+				break
+			}
+
+			buf.WriteStrings(tableNames, ", ")
+
+		default:
+			buf.WriteStrings(tableNames, ", ")
+		}
 	}
 
 	sb.injection.WriteTo(buf, selectMarkerAfterFrom)
 
 	for i := range sb.joinTables {
 		if option := sb.joinOptions[i]; option != "" {
-			buf.WriteLeadingString(string(option))
+			opts.writeClause(buf, true, string(option))
+			buf.WriteString(" JOIN ")
+		} else {
+			opts.writeClause(buf, true, "JOIN ")
 		}
 
-		buf.WriteLeadingString("JOIN ")
 		buf.WriteString(sb.joinTables[i])
 
-		if exprs := sb.joinExprs[i]; len(exprs) > 0 {
+		if exprs := sb.joinExprs[i]; len(exprs) > 0 && sb.joinOptions[i].hasJoinCondition() {
 			buf.WriteString(" ON ")
 			buf.WriteStrings(sb.joinExprs[i], " AND ")
 		}
@@ -402,28 +1469,66 @@ func (sb *SelectBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 
 	if sb.WhereClause != nil {
 		sb.whereClauseProxy.WhereClause = sb.WhereClause
+		sb.whereClauseProxy.andSep = opts.andSep()
 		defer func() {
 			sb.whereClauseProxy.WhereClause = nil
+			sb.whereClauseProxy.andSep = ""
 		}()
 
-		buf.WriteLeadingString(sb.whereClauseExpr)
+		opts.writeClause(buf, false, sb.whereClauseExpr)
 		sb.injection.WriteTo(buf, selectMarkerAfterWhere)
 	}
 
-	if len(sb.groupByCols) > 0 {
-		buf.WriteLeadingString("GROUP BY ")
-		buf.WriteStrings(sb.groupByCols, ", ")
+	groupByCols := sb.groupByCols
+	groupByAll := sb.groupByAll && (flavor == ClickHouse || flavor == DuckDB)
+
+	if sb.groupByAll && !groupByAll {
+		groupByCols = append(sb.groupByAllCols(flavor), groupByCols...)
+	}
 
-		if len(sb.havingExprs) > 0 {
-			buf.WriteString(" HAVING ")
-			buf.WriteStrings(sb.havingExprs, " AND ")
+	if groupByAll || len(groupByCols) > 0 {
+		if groupByAll {
+			opts.writeClause(buf, false, "GROUP BY ALL")
+		} else {
+			opts.writeClause(buf, false, "GROUP BY ")
+			buf.WriteStrings(groupByCols, ", ")
+
+			if sb.withRollup && flavor.isMySQLCompatible() {
+				buf.WriteString(" WITH ROLLUP")
+			}
+		}
+
+		if sb.HavingClause != nil {
+			sb.havingClauseProxy.HavingClause = sb.HavingClause
+			defer func() {
+				sb.havingClauseProxy.HavingClause = nil
+			}()
+
+			opts.writeClause(buf, false, sb.havingClauseExpr)
 		}
 
 		sb.injection.WriteTo(buf, selectMarkerAfterGroupBy)
 	}
 
+	if len(sb.windowNames) > 0 && flavor.supportsWindowClause() {
+		opts.writeClause(buf, false, "WINDOW ")
+
+		for i, name := range sb.windowNames {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+
+			buf.WriteString(name)
+			buf.WriteString(" AS (")
+			buf.WriteString(sb.windowSpecs[i].String())
+			buf.WriteRune(')')
+		}
+
+		sb.injection.WriteTo(buf, selectMarkerAfterWindow)
+	}
+
 	if len(sb.orderByCols) > 0 {
-		buf.WriteLeadingString("ORDER BY ")
+		opts.writeClause(buf, false, "ORDER BY ")
 		buf.WriteStrings(sb.orderByCols, ", ")
 
 		if sb.order != "" {
@@ -435,30 +1540,30 @@ func (sb *SelectBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 	}
 
 	switch flavor {
-	case MySQL, SQLite, ClickHouse:
+	case MySQL, MariaDB, SQLite, ClickHouse:
 		if sb.limit >= 0 {
-			buf.WriteLeadingString("LIMIT ")
-			buf.WriteString(strconv.Itoa(sb.limit))
+			opts.writeClause(buf, false, "LIMIT ")
+			buf.WriteString(sb.limitString())
 
 			if sb.offset >= 0 {
 				buf.WriteLeadingString("OFFSET ")
-				buf.WriteString(strconv.Itoa(sb.offset))
+				buf.WriteString(sb.offsetString())
 			}
 		}
 	case CQL:
 		if sb.limit >= 0 {
-			buf.WriteLeadingString("LIMIT ")
-			buf.WriteString(strconv.Itoa(sb.limit))
+			opts.writeClause(buf, false, "LIMIT ")
+			buf.WriteString(sb.limitString())
 		}
-	case PostgreSQL, Presto:
+	case PostgreSQL, Presto, ANSI, DuckDB:
 		if sb.limit >= 0 {
-			buf.WriteLeadingString("LIMIT ")
-			buf.WriteString(strconv.Itoa(sb.limit))
+			opts.writeClause(buf, false, "LIMIT ")
+			buf.WriteString(sb.limitString())
 		}
 
 		if sb.offset >= 0 {
 			buf.WriteLeadingString("OFFSET ")
-			buf.WriteString(strconv.Itoa(sb.offset))
+			buf.WriteString(sb.offsetString())
 		}
 
 	case SQLServer:
@@ -470,7 +1575,7 @@ func (sb *SelectBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 
 		if sb.offset >= 0 {
 			buf.WriteLeadingString("OFFSET ")
-			buf.WriteString(strconv.Itoa(sb.offset))
+			buf.WriteString(sb.offsetString())
 			buf.WriteString(" ROWS")
 		}
 
@@ -480,7 +1585,7 @@ func (sb *SelectBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 			}
 
 			buf.WriteLeadingString("FETCH NEXT ")
-			buf.WriteString(strconv.Itoa(sb.limit))
+			buf.WriteString(sb.limitString())
 			buf.WriteString(" ROWS ONLY")
 		}
 
@@ -491,20 +1596,34 @@ func (sb *SelectBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 				buf.WriteStrings(sb.tables, ", ")
 			}
 
-			min := sb.offset
-			if min < 0 {
-				min = 0
-			}
-
 			buf.WriteString(" ) WHERE ")
-			if sb.limit >= 0 {
-				buf.WriteString("r BETWEEN ")
-				buf.WriteString(strconv.Itoa(min + 1))
-				buf.WriteString(" AND ")
-				buf.WriteString(strconv.Itoa(sb.limit + min))
+
+			if sb.limitVar != "" || sb.offsetVar != "" {
+				min := "0"
+				if sb.offset >= 0 {
+					min = sb.offsetString()
+				}
+
+				if sb.limit >= 0 {
+					buf.WriteStrings([]string{"r BETWEEN ", min, " + 1 AND ", sb.limitString(), " + ", min}, "")
+				} else {
+					buf.WriteStrings([]string{"r >= ", min, " + 1"}, "")
+				}
 			} else {
-				buf.WriteString("r >= ")
-				buf.WriteString(strconv.Itoa(min + 1))
+				min := sb.offset
+				if min < 0 {
+					min = 0
+				}
+
+				if sb.limit >= 0 {
+					buf.WriteString("r BETWEEN ")
+					buf.WriteString(strconv.Itoa(min + 1))
+					buf.WriteString(" AND ")
+					buf.WriteString(strconv.Itoa(sb.limit + min))
+				} else {
+					buf.WriteString("r >= ")
+					buf.WriteString(strconv.Itoa(min + 1))
+				}
 			}
 		}
 	case Informix:
@@ -513,11 +1632,11 @@ func (sb *SelectBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 		if sb.limit > 0 {
 			if sb.offset >= 0 {
 				buf.WriteLeadingString("SKIP ")
-				buf.WriteString(strconv.Itoa(sb.offset))
+				buf.WriteString(sb.offsetString())
 			}
 
 			buf.WriteLeadingString("FIRST ")
-			buf.WriteString(strconv.Itoa(sb.limit))
+			buf.WriteString(sb.limitString())
 		}
 	}
 
@@ -526,13 +1645,31 @@ func (sb *SelectBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 	}
 
 	if sb.forWhat != "" {
-		buf.WriteLeadingString("FOR ")
+		opts.writeClause(buf, false, "FOR ")
 		buf.WriteString(sb.forWhat)
 
+		switch flavor {
+		case MySQL, MariaDB, PostgreSQL, Oracle:
+			if len(sb.forOf) > 0 {
+				buf.WriteString(" OF ")
+				buf.WriteStrings(sb.forOf, ", ")
+			}
+
+			if sb.forWait != "" {
+				buf.WriteLeadingString(sb.forWait)
+			}
+		}
+
 		sb.injection.WriteTo(buf, selectMarkerAfterFor)
 	}
 
-	return sb.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+	sql, args = sb.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+
+	if opts != nil && opts.UppercaseKeywords {
+		sql = uppercaseKeywords(sql)
+	}
+
+	return
 }
 
 // SetFlavor sets the flavor of compiled sql.
@@ -552,3 +1689,9 @@ func (sb *SelectBuilder) SQL(sql string) *SelectBuilder {
 	sb.injection.SQL(sb.marker, sql)
 	return sb
 }
+
+// SQLf adds an arbitrary sql built by `Build` with format and args to current position.
+// It's a shortcut of `sb.SQL(sb.Var(Build(format, args...)))`.
+func (sb *SelectBuilder) SQLf(format string, args ...interface{}) *SelectBuilder {
+	return sb.SQL(sb.Var(Build(format, args...)))
+}
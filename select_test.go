@@ -4,7 +4,9 @@
 package sqlbuilder
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -122,7 +124,7 @@ func ExampleSelectBuilder_join() {
 }
 
 func ExampleSelectBuilder_limit_offset() {
-	flavors := []Flavor{MySQL, PostgreSQL, SQLite, SQLServer, CQL, ClickHouse, Presto, Oracle, Informix}
+	flavors := []Flavor{MySQL, PostgreSQL, SQLite, SQLServer, CQL, ClickHouse, Presto, Oracle, Informix, ANSI, DuckDB}
 	results := make([][]string, len(flavors))
 	sb := NewSelectBuilder()
 	saveResults := func() {
@@ -250,6 +252,20 @@ func ExampleSelectBuilder_limit_offset() {
 	// #3: SELECT * FROM user SKIP 0 FIRST 1
 	// #4: SELECT * FROM user FIRST 1
 	// #5: SELECT * FROM user ORDER BY id SKIP 1 FIRST 1
+	//
+	// ANSI
+	// #1: SELECT * FROM user
+	// #2: SELECT * FROM user OFFSET 0
+	// #3: SELECT * FROM user LIMIT 1 OFFSET 0
+	// #4: SELECT * FROM user LIMIT 1
+	// #5: SELECT * FROM user ORDER BY id LIMIT 1 OFFSET 1
+	//
+	// DuckDB
+	// #1: SELECT * FROM user
+	// #2: SELECT * FROM user OFFSET 0
+	// #3: SELECT * FROM user LIMIT 1 OFFSET 0
+	// #4: SELECT * FROM user LIMIT 1
+	// #5: SELECT * FROM user ORDER BY id LIMIT 1 OFFSET 1
 }
 
 func ExampleSelectBuilder_ForUpdate() {
@@ -350,6 +366,83 @@ func ExampleSelectBuilder_NumCol() {
 	// 3
 }
 
+func ExampleSelectBuilder_NumOrderBy() {
+	sb := NewSelectBuilder()
+	sb.Select("id", "name").From("user")
+	sb.Where(sb.GreaterThan("id", 1234))
+
+	// Apply a default order only if no ORDER BY is added.
+	if sb.NumOrderBy() == 0 {
+		sb.OrderBy("id").Desc()
+	}
+
+	fmt.Println(sb)
+
+	// Output:
+	// SELECT id, name FROM user WHERE id > ? ORDER BY id DESC
+}
+
+func ExampleSelectBuilder_ClearOrderBy() {
+	sb := NewSelectBuilder()
+	sb.Select("id", "name").From("user")
+	sb.Where(sb.GreaterThan("id", 1234))
+	sb.OrderBy("id").Desc()
+	sb.Limit(20).Offset(40)
+
+	// Turn the paginated query into a count query.
+	sb.ClearOrderBy().ClearLimitOffset().ClearSelect().SelectMore("COUNT(*)")
+
+	sql, args := sb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT COUNT(*) FROM user WHERE id > ?
+	// [1234]
+}
+
+func TestSelectBuilderClear(t *testing.T) {
+	a := assert.New(t)
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("status", "COUNT(*)").From("user")
+	sb.GroupByRollup("status").Having("COUNT(*) > 1").SQL("/* group by */")
+	sb.OrderBy("status").Asc().SQL("/* order by */")
+	sb.LimitVar(10).OffsetVar(20).SQL("/* limit */")
+
+	sb.ClearGroupBy().ClearOrderBy().ClearLimitOffset()
+	sql, args := sb.Build()
+	a.Equal(sql, "SELECT status, COUNT(*) FROM user")
+	a.Equal(len(args), 0)
+
+	sb.GroupBy("status").OrderBy("status").Limit(5)
+	a.Equal(sb.String(), "SELECT status, COUNT(*) FROM user GROUP BY status HAVING COUNT(*) > 1 ORDER BY status LIMIT 5")
+
+	sb.ClearSelect()
+	a.Equal(sb.NumCol(), 0)
+}
+func TestSelectBuilderNumAccessors(t *testing.T) {
+	a := assert.New(t)
+	sb := newSelectBuilder()
+	a.Equal(sb.NumJoin(), 0)
+	a.Equal(sb.NumWhere(), 0)
+	a.Equal(sb.NumGroupBy(), 0)
+	a.Equal(sb.NumOrderBy(), 0)
+
+	sb.Select("u.id", "COUNT(*)").From("user u")
+	sb.Join("orders o", "o.user_id = u.id")
+	sb.JoinWithOption(LeftJoin, "profile p", "p.user_id = u.id")
+	sb.Where("u.id > 1", "", "u.status = 1")
+	sb.Where()
+	sb.WhereClause.AddWhereExprOr(sb.Args, "u.role = 'admin'")
+	sb.GroupBy("u.id", "u.name")
+	sb.OrderBy("u.id")
+
+	a.Equal(sb.NumJoin(), 2)
+	a.Equal(sb.NumWhere(), 3)
+	a.Equal(sb.NumGroupBy(), 2)
+	a.Equal(sb.NumOrderBy(), 1)
+}
+
 func ExampleSelectBuilder_With() {
 	sql := With(
 		CTEQuery("users").As(
@@ -414,3 +507,769 @@ func ExampleSelectBuilder_LateralAs() {
 	// Output:
 	// SELECT salesperson.name, max_sale.amount, max_sale.customer_name FROM salesperson, LATERAL (SELECT amount, customer_name FROM all_sales WHERE all_sales.salesperson_id = salesperson.id ORDER BY amount DESC LIMIT 1) AS max_sale
 }
+
+func TestSelectBuilderBuildContext(t *testing.T) {
+	a := assert.New(t)
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "span")
+	sb := Select("id").From("user")
+	sb.Where(sb.E("id", 1))
+
+	// OnBuild is not set.
+	sql, args := sb.BuildContext(ctx)
+	a.Equal(sql, "SELECT id FROM user WHERE id = ?")
+	a.Equal(args, []interface{}{1})
+
+	var hookedSQL, hookedType string
+	var hookedValue interface{}
+	OnBuild = func(ctx context.Context, sql string, builderType string) {
+		hookedSQL = sql
+		hookedType = builderType
+		hookedValue = ctx.Value(ctxKey{})
+	}
+	defer func() {
+		OnBuild = nil
+	}()
+
+	sql, args = sb.BuildContext(ctx)
+	a.Equal(sql, "SELECT id FROM user WHERE id = ?")
+	a.Equal(args, []interface{}{1})
+	a.Equal(hookedSQL, sql)
+	a.Equal(hookedType, "SELECT")
+	a.Equal(hookedValue, "span")
+}
+
+func ExampleSelectBuilder_WhereNot() {
+	sb := NewSelectBuilder()
+	sb.Select("id").From("user")
+	sb.Where(sb.GreaterThan("level", 10))
+	sb.WhereNot(sb.Or(sb.Equal("status", 1), sb.Equal("status", 2)), "")
+	sb.WhereNotIn("type", "a", "b")
+	sb.WhereNotEqual("name", "admin")
+	sb.WhereNotLike("email", "%@example.com")
+	sb.WhereNotBetween("age", 10, 20)
+
+	sql, args := sb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT id FROM user WHERE level > ? AND NOT ((status = ? OR status = ?)) AND type NOT IN (?, ?) AND name <> ? AND email NOT LIKE ? AND age NOT BETWEEN ? AND ?
+	// [10 1 2 a b admin %@example.com 10 20]
+}
+
+func TestSelectBuilderWhereNot(t *testing.T) {
+	a := assert.New(t)
+	sb := NewSelectBuilder()
+	sb.Select("*").From("t").WhereNot("", "")
+	a.Equal(sb.String(), "SELECT * FROM t")
+
+	sb.WhereNot("a = 1", "b = 2")
+	a.Equal(sb.String(), "SELECT * FROM t WHERE NOT (a = 1) AND NOT (b = 2)")
+}
+
+func ExampleSelectBuilder_SelectSubquery() {
+	sub := Select("COUNT(*)").From("orders o")
+	sub.Where("o.user_id = u.id", sub.GreaterThan("o.amount", 100))
+
+	sb := NewSelectBuilder()
+	sb.Select("u.id").SelectSubquery(sub, "order_count").From("users u")
+	sb.Where(sb.Equal("u.status", 1))
+
+	sql, args := sb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT u.id, (SELECT COUNT(*) FROM orders o WHERE o.user_id = u.id AND o.amount > ?) AS order_count FROM users u WHERE u.status = ?
+	// [100 1]
+}
+
+func ExampleSelectBuilder_BuildPretty() {
+	sb := NewSelectBuilder()
+	sb.Select("u.id", "u.name", "COUNT(o.id) AS order_count")
+	sb.From("user u")
+	sb.JoinWithOption(LeftJoin, "orders o", "o.user_id = u.id")
+	sb.Where(
+		sb.GreaterThan("u.level", 10),
+		sb.Or(sb.Equal("u.status", 1), sb.Equal("u.status", 2)),
+	)
+	sb.GroupBy("u.id").Having("order_count > 0")
+	sb.OrderBy("u.id").Desc()
+	sb.Limit(10)
+
+	sql, args := sb.BuildPretty(DefaultFormatOptions)
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT u.id, u.name, COUNT(o.id) AS order_count
+	// FROM user u
+	//   LEFT JOIN orders o ON o.user_id = u.id
+	// WHERE u.level > ?
+	//   AND (u.status = ? OR u.status = ?)
+	// GROUP BY u.id
+	// HAVING order_count > 0
+	// ORDER BY u.id DESC
+	// LIMIT 10
+	// [10 1 2]
+}
+
+func TestSelectBuilderBuildPretty(t *testing.T) {
+	a := assert.New(t)
+	sb := NewSelectBuilder()
+	sb.Select("id").From("t").Join("t2", "t.id = t2.id")
+	sb.Where(sb.Equal("a", 1), sb.Equal("b", 2))
+	compact, args := sb.Build()
+
+	sql, prettyArgs := sb.BuildPretty(FormatOptions{})
+	a.Equal(sql, compact)
+	a.Equal(prettyArgs, args)
+
+	sql, _ = sb.BuildPretty(FormatOptions{Indent: "\t", NewlineBetweenConditions: true})
+	a.Equal(sql, "SELECT id FROM t JOIN t2 ON t.id = t2.id WHERE a = ?\n\tAND b = ?")
+
+	// Formatting must not affect subsequent Build.
+	sql, _ = sb.Build()
+	a.Equal(sql, compact)
+
+	sb = NewSelectBuilder()
+	sb.Select("id", "case when x is null then 1 else 0 end AS flag").From("t")
+	sb.Where("t.order > 0 and name not like 'select%'", "/* and */ `in` = 1")
+	sql, _ = sb.BuildPretty(FormatOptions{UppercaseKeywords: true})
+	a.Equal(sql, "SELECT id, CASE WHEN x IS NULL THEN 1 ELSE 0 END AS flag FROM t WHERE t.order > 0 AND name NOT LIKE 'select%' AND /* and */ `in` = 1")
+}
+
+func TestSelectBuilderJoinOnEqual(t *testing.T) {
+	a := assert.New(t)
+	sb := NewSelectBuilder()
+	sb.Select("o.id", "i.sku").From("orders o")
+	sb.JoinOnEqual("order_items i", [2]string{"o.id", "i.order_id"}, [2]string{"o.region", "i.order_region"})
+	a.Equal(sb.String(), "SELECT o.id, i.sku FROM orders o JOIN order_items i ON o.id = i.order_id AND o.region = i.order_region")
+
+	sb = NewSelectBuilder()
+	sb.Select("*").From("a")
+	sb.JoinOnEqual("b", [2]string{"a.x", "b.x"}, [2]string{"a.y", "b.y"}, [2]string{"a.$z", "b.$z"})
+	sb.Where(sb.Equal("a.id", 1))
+	sql, args := sb.Build()
+	a.Equal(sql, "SELECT * FROM a JOIN b ON a.x = b.x AND a.y = b.y AND a.$z = b.$z WHERE a.id = ?")
+	a.Equal(args, []interface{}{1})
+}
+
+func ExampleSelectBuilder_CrossJoinLateral() {
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("c.name", "top_orders.amount")
+	sb.From("customers c")
+
+	sub := PostgreSQL.NewSelectBuilder()
+	sub.Select("amount").From("orders o")
+	sub.Where("o.customer_id = c.id", sub.GreaterThan("o.amount", 100))
+	sub.OrderBy("amount").Desc().Limit(3)
+
+	sb.CrossJoinLateral(sub, "top_orders")
+	sb.Where(sb.Equal("c.region", "EU"))
+
+	sql, args := sb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT c.name, top_orders.amount FROM customers c CROSS JOIN LATERAL (SELECT amount FROM orders o WHERE o.customer_id = c.id AND o.amount > $1 ORDER BY amount DESC LIMIT 3) AS top_orders WHERE c.region = $2
+	// [100 EU]
+}
+
+func TestSelectBuilderLeftJoinLateral(t *testing.T) {
+	a := assert.New(t)
+	sub := Select("MAX(amount) AS amount").From("orders o").Where("o.customer_id = c.id")
+
+	sb := NewSelectBuilder()
+	sb.Select("c.name", "m.amount").From("customers c").LeftJoinLateral(sub, "m")
+	a.Equal(sb.String(), "SELECT c.name, m.amount FROM customers c LEFT JOIN LATERAL (SELECT MAX(amount) AS amount FROM orders o WHERE o.customer_id = c.id) AS m ON TRUE")
+
+	sb = NewSelectBuilder()
+	sb.Select("c.name", "m.amount").From("customers c").LeftJoinLateral(sub, "m", sb.GreaterThan("m.amount", 10))
+	sql, args := sb.Build()
+	a.Equal(sql, "SELECT c.name, m.amount FROM customers c LEFT JOIN LATERAL (SELECT MAX(amount) AS amount FROM orders o WHERE o.customer_id = c.id) AS m ON m.amount > ?")
+	a.Equal(args, []interface{}{10})
+}
+
+func ExampleSelectBuilder_ForSystemTimeAsOf() {
+	sb := SQLServer.NewSelectBuilder()
+	sb.Select("id", "salary").From("employee")
+	sb.ForSystemTimeAsOf("2024-01-01 00:00:00")
+	sb.Where(sb.Equal("department", "R&D"))
+
+	sql, args := sb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT id, salary FROM employee FOR SYSTEM_TIME AS OF @p1 WHERE department = @p2
+	// [2024-01-01 00:00:00 R&D]
+}
+
+func TestSelectBuilderForSystemTime(t *testing.T) {
+	a := assert.New(t)
+	sb := NewSelectBuilder()
+	sb.Select("*").From("t1", "t2").ForSystemTimeBetween("2024-01-01", "2024-02-01")
+	sb.Where(sb.Equal("t1.id", 1))
+
+	sql, args := sb.BuildWithFlavor(MariaDB)
+	a.Equal(sql, "SELECT * FROM t1 FOR SYSTEM_TIME BETWEEN ? AND ?, t2 WHERE t1.id = ?")
+	a.Equal(args, []interface{}{"2024-01-01", "2024-02-01", 1})
+
+	// Ignored in flavors without temporal table support.
+	sql, args = sb.BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "SELECT * FROM t1, t2 WHERE t1.id = $1")
+	a.Equal(args, []interface{}{1})
+
+	sql, _ = sb.BuildWithFlavor(MySQL)
+	a.Equal(sql, "SELECT * FROM t1, t2 WHERE t1.id = ?")
+
+	_, _, err := sb.BuildWithFlavorStrict(MySQL)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+
+	// The alias is written after FOR SYSTEM_TIME.
+	sb = SQLServer.NewSelectBuilder()
+	sb.Select("u.id").From("users u").ForSystemTimeAsOf("2024-01-01")
+	a.Equal(sb.String(), "SELECT u.id FROM users FOR SYSTEM_TIME AS OF @p1 u")
+
+	sb.From("users AS u")
+	a.Equal(sb.String(), "SELECT u.id FROM users FOR SYSTEM_TIME AS OF @p1 AS u")
+}
+
+func ExampleSelectBuilder_Window() {
+	sb := NewSelectBuilder()
+	sb.Window("w", WindowSpec{
+		PartitionBy: []string{"user_id"},
+		OrderBy:     []string{"created_at DESC"},
+	})
+	sb.Select(
+		"id",
+		sb.OverWindow("ROW_NUMBER()", "w"),
+		sb.OverWindow("SUM(amount)", "w")+" AS total",
+	)
+	sb.From("orders")
+	sb.OrderBy("id")
+
+	fmt.Println(sb.BuildWithFlavor(PostgreSQL))
+	fmt.Println(sb.BuildWithFlavor(SQLServer))
+
+	// Output:
+	// SELECT id, ROW_NUMBER() OVER w, SUM(amount) OVER w AS total FROM orders WINDOW w AS (PARTITION BY user_id ORDER BY created_at DESC) ORDER BY id []
+	// SELECT id, ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY created_at DESC), SUM(amount) OVER (PARTITION BY user_id ORDER BY created_at DESC) AS total FROM orders ORDER BY id []
+}
+
+func TestSelectBuilderWindow(t *testing.T) {
+	a := assert.New(t)
+	sb := NewSelectBuilder()
+	sb.Select(sb.OverWindow("AVG(x)", "w1"), sb.OverWindow("AVG(y)", "w2")).From("t")
+	sb.GroupBy("g").Having("COUNT(*) > 1")
+	sb.Window("w1", WindowSpec{OrderBy: []string{"a"}})
+	sb.Window("w2", WindowSpec{PartitionBy: []string{"b", "c"}, Frame: "ROWS BETWEEN 1 PRECEDING AND CURRENT ROW"})
+	sb.SQL("/* after window */")
+	sb.Window("w1", WindowSpec{OrderBy: []string{"d"}})
+
+	a.Equal(sb.String(), "SELECT AVG(x) OVER w1, AVG(y) OVER w2 FROM t GROUP BY g HAVING COUNT(*) > 1 WINDOW w1 AS (ORDER BY d), w2 AS (PARTITION BY b, c ROWS BETWEEN 1 PRECEDING AND CURRENT ROW) /* after window */")
+
+	sql, _ := sb.BuildWithFlavor(Informix)
+	a.Equal(sql, "SELECT AVG(x) OVER (ORDER BY d), AVG(y) OVER (PARTITION BY b, c ROWS BETWEEN 1 PRECEDING AND CURRENT ROW) FROM t GROUP BY g HAVING COUNT(*) > 1")
+
+	a.Equal(WindowSpec{}.String(), "")
+}
+
+func TestSelectBuilderOrderByCollate(t *testing.T) {
+	a := assert.New(t)
+	sb := NewSelectBuilder()
+	sb.Select("name").From("user").OrderBy("id").OrderByCollate("name", "C", true).OrderByCollate("nick", "C", false)
+
+	sql, _ := sb.BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, `SELECT name FROM user ORDER BY id, name COLLATE "C" DESC, nick COLLATE "C"`)
+
+	sql, _ = sb.BuildWithFlavor(MySQL)
+	a.Equal(sql, "SELECT name FROM user ORDER BY id, name COLLATE C DESC, nick COLLATE C")
+}
+
+func TestSelectBuilderNumTables(t *testing.T) {
+	a := assert.New(t)
+	sb := NewSelectBuilder()
+	sb.Select("*")
+	a.Equal(sb.NumTables(), 0)
+
+	sb.From("a", "b")
+	a.Equal(sb.NumTables(), 2)
+	a.Equal(sb.TableNames(), []string{"a", "b"})
+
+	sb.With(With(CTETable("c").As(Select("1")), CTEQuery("d").As(Select("2"))))
+	a.Equal(sb.NumTables(), 3)
+	a.Equal(sb.TableNames(), []string{"a", "b", "c"})
+}
+
+func TestSelectBuilderColumns(t *testing.T) {
+	a := assert.New(t)
+	sub := Select("COUNT(*)").From("orders o").Where("o.user_id = u.id")
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("u.id", "u.name AS user_name").SelectSubquery(sub, "order_count").From("users u")
+	sb.SelectMore(sb.Var(Raw("1")) + " AS one")
+
+	a.Equal(sb.Columns(), []ColumnRef{
+		{Expr: "u.id"},
+		{Expr: "u.name", Alias: "user_name"},
+		{Expr: "(SELECT COUNT(*) FROM orders o WHERE o.user_id = u.id)", Alias: "order_count"},
+		{Expr: "1", Alias: "one"},
+	})
+}
+
+func ExampleSelectBuilder_GroupByAll() {
+	sb := NewSelectBuilder()
+	sb.Select("user_id", "DATE(created_at) AS d", "SUM(amount) AS total", "COUNT(*)")
+	sb.From("orders")
+	sb.GroupByAll()
+	sb.Having("SUM(amount) > 100")
+
+	fmt.Println(sb)
+
+	sb.SetFlavor(ClickHouse)
+	fmt.Println(sb)
+
+	// Output:
+	// SELECT user_id, DATE(created_at) AS d, SUM(amount) AS total, COUNT(*) FROM orders GROUP BY user_id, DATE(created_at) HAVING SUM(amount) > 100
+	// SELECT user_id, DATE(created_at) AS d, SUM(amount) AS total, COUNT(*) FROM orders GROUP BY ALL HAVING SUM(amount) > 100
+}
+
+func TestSelectGroupByAll(t *testing.T) {
+	a := assert.New(t)
+	sb := NewSelectBuilder()
+	sb.Select("*", "u.*", "u.name", "'sum(x)' AS s", "max (score)", "summary", "uniqExact(ip)")
+	sb.From("user u")
+	sb.GroupByAll()
+	sb.GroupBy("u.id")
+	a.Equal(sb.String(), "SELECT *, u.*, u.name, 'sum(x)' AS s, max (score), summary, uniqExact(ip) FROM user u GROUP BY u.name, 'sum(x)', summary, u.id")
+
+	sb = NewSelectBuilder()
+	sb.Select("COUNT(*)").From("user").GroupByAll()
+	a.Equal(sb.String(), "SELECT COUNT(*) FROM user")
+}
+
+func ExampleSelectBuilder_Over() {
+	sb := NewSelectBuilder()
+	sb.Select(
+		"user_id",
+		sb.Over("SUM(amount)", []string{"user_id"}, []string{"created_at DESC"}),
+		sb.Over("AVG(amount)", nil, []string{"created_at"}, "ROWS BETWEEN", sb.Var(3), "PRECEDING AND CURRENT ROW"),
+	)
+	sb.From("orders")
+	sb.WindowAs("w", WindowSpec{PartitionBy: []string{"user_id"}})
+
+	sql, args := sb.BuildWithFlavor(PostgreSQL)
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT user_id, SUM(amount) OVER (PARTITION BY user_id ORDER BY created_at DESC), AVG(amount) OVER (ORDER BY created_at ROWS BETWEEN $1 PRECEDING AND CURRENT ROW) FROM orders WINDOW w AS (PARTITION BY user_id)
+	// [3]
+}
+
+func ExampleSelectBuilder_OrderByCol() {
+	sb := NewSelectBuilder()
+	sb.Select("*").From("user")
+	sb.OrderByCol("name", Ascending)
+	sb.OrderByCol("score", Descending, NullsLast)
+
+	fmt.Println(sb.BuildWithFlavor(PostgreSQL))
+	fmt.Println(sb.BuildWithFlavor(MySQL))
+
+	// Output:
+	// SELECT * FROM user ORDER BY name ASC, score DESC NULLS LAST []
+	// SELECT * FROM user ORDER BY name ASC, CASE WHEN score IS NULL THEN 1 ELSE 0 END, score DESC []
+}
+
+func TestSelectOrderByCol(t *testing.T) {
+	a := assert.New(t)
+	sb := NewSelectBuilder()
+	sb.Select("*").From("user")
+	sb.OrderByCol("a", NullsFirst)
+	sb.OrderBy("b").Desc()
+
+	cases := map[Flavor]string{
+		PostgreSQL: "SELECT * FROM user ORDER BY a NULLS FIRST, b DESC",
+		SQLServer:  "SELECT * FROM user ORDER BY CASE WHEN a IS NULL THEN 0 ELSE 1 END, a, b DESC",
+		CQL:        "SELECT * FROM user ORDER BY a, b DESC",
+		SQLite:     "SELECT * FROM user ORDER BY a NULLS FIRST, b DESC",
+	}
+
+	for flavor, expected := range cases {
+		sql, _ := sb.BuildWithFlavor(flavor)
+		a.Equal(sql, expected)
+	}
+}
+
+func ExampleSelectBuilder_GroupByRollup() {
+	sb := NewSelectBuilder()
+	sb.Select("year", "region", "SUM(amount)").From("sales")
+	sb.GroupByRollup("year", "region")
+
+	fmt.Println(sb.BuildWithFlavor(MySQL))
+	fmt.Println(sb.BuildWithFlavor(PostgreSQL))
+
+	// Output:
+	// SELECT year, region, SUM(amount) FROM sales GROUP BY year, region WITH ROLLUP []
+	// SELECT year, region, SUM(amount) FROM sales GROUP BY ROLLUP(year, region) []
+}
+
+func ExampleSelectBuilder_GroupByGroupingSets() {
+	sb := NewSelectBuilder()
+	sb.Select("year", "region", "SUM(amount)").From("sales")
+	sb.GroupBy("country")
+	sb.GroupByGroupingSets([]string{"year"}, []string{"region"}, nil)
+
+	fmt.Println(sb)
+
+	// Output:
+	// SELECT year, region, SUM(amount) FROM sales GROUP BY country, GROUPING SETS ((year), (region), ())
+}
+
+func TestSelectGroupByCube(t *testing.T) {
+	a := assert.New(t)
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("a", "b", "COUNT(*)").From("t")
+	sb.GroupBy("c").GroupByCube("a", "b").Having("COUNT(*) > 1")
+	a.Equal(sb.String(), "SELECT a, b, COUNT(*) FROM t GROUP BY c, CUBE(a, b) HAVING COUNT(*) > 1")
+}
+
+func ExampleSelectBuilder_SkipLocked() {
+	sb := NewSelectBuilder()
+	sb.Select("*").From("orders", "users")
+	sb.Where(sb.Equal("orders.status", "pending"))
+	sb.Limit(10)
+	sb.ForUpdate().Of("orders").SkipLocked()
+
+	fmt.Println(sb.BuildWithFlavor(PostgreSQL))
+
+	// Output:
+	// SELECT * FROM orders, users WHERE orders.status = $1 LIMIT 10 FOR UPDATE OF orders SKIP LOCKED [pending]
+}
+
+func TestSelectLockOptions(t *testing.T) {
+	a := assert.New(t)
+	sb := NewSelectBuilder()
+	sb.Select("*").From("orders").ForShare().NoWait()
+
+	sql, _ := sb.BuildWithFlavor(MySQL)
+	a.Equal(sql, "SELECT * FROM orders FOR SHARE NOWAIT")
+
+	sb.ForUpdate().Of("orders")
+	sql, _ = sb.BuildWithFlavor(SQLite)
+	a.Equal(sql, "SELECT * FROM orders FOR UPDATE")
+}
+
+func ExampleSelectBuilder_DistinctOn() {
+	sb := NewSelectBuilder()
+	sb.Select("user_id", "amount", "created_at").From("orders")
+	sb.DistinctOn("user_id")
+	sb.OrderBy("user_id", "created_at DESC")
+
+	fmt.Println(sb.BuildWithFlavor(PostgreSQL))
+	fmt.Println(sb.BuildWithFlavor(MySQL))
+
+	// Output:
+	// SELECT DISTINCT ON (user_id) user_id, amount, created_at FROM orders ORDER BY user_id, created_at DESC []
+	// SELECT DISTINCT user_id, amount, created_at FROM orders ORDER BY user_id, created_at DESC []
+}
+
+func TestSelectDistinctOnOrderBy(t *testing.T) {
+	a := assert.New(t)
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("*").From("orders").DistinctOn("user_id", "product_id")
+
+	a.Equal(sb.String(), "SELECT DISTINCT ON (user_id, product_id) * FROM orders")
+
+	_, _, err := sb.BuildE()
+	a.NilError(err)
+
+	sb.OrderBy("product_id DESC NULLS LAST", "created_at")
+	a.Equal(sb.String(), "SELECT DISTINCT ON (user_id, product_id) * FROM orders ORDER BY product_id DESC NULLS LAST, created_at")
+
+	_, _, err = sb.BuildE()
+	a.Assert(errors.Is(err, ErrDistinctOnMismatch))
+	a.Equal(err.Error(), "go-sqlbuilder: DISTINCT ON expressions must match leading ORDER BY expressions: user_id not in leading ORDER BY")
+
+	_, _, err = sb.BuildWithFlavorStrict(DuckDB)
+	a.NilError(err)
+
+	sb = PostgreSQL.NewSelectBuilder()
+	sb.Select("*").From("orders").DistinctOn("user_id").OrderBy("user_id").Desc()
+	a.Equal(sb.String(), "SELECT DISTINCT ON (user_id) * FROM orders ORDER BY user_id DESC")
+
+	_, _, err = sb.BuildE()
+	a.NilError(err)
+
+	sb = PostgreSQL.NewSelectBuilder()
+	sb.Select("*").From("orders").DistinctOn("user_id").OrderByCol("user_id", Descending).OrderByCol("created_at")
+	a.Equal(sb.String(), "SELECT DISTINCT ON (user_id) * FROM orders ORDER BY user_id DESC, created_at")
+
+	_, _, err = sb.BuildE()
+	a.NilError(err)
+
+	sb = PostgreSQL.NewSelectBuilder()
+	sb.Select("*").From("orders").DistinctOn("user_id").OrderByCol("created_at").OrderByCol("user_id")
+	_, _, err = sb.BuildE()
+	a.Assert(errors.Is(err, ErrDistinctOnMismatch))
+}
+
+func ExampleSelectBuilder_JoinUsing() {
+	sb := NewSelectBuilder()
+	sb.Select("u.id", "e.email", "p.phone").From("users u")
+	sb.JoinUsing("", "emails e", "user_id")
+	sb.JoinUsing(LeftJoin, "phones p", "user_id", "region_id")
+	sb.Join("profiles pr", "pr.id = u.profile_id")
+	sb.SQL("/* after join */")
+
+	fmt.Println(sb)
+
+	// Output:
+	// SELECT u.id, e.email, p.phone FROM users u JOIN emails e USING (user_id) LEFT JOIN phones p USING (user_id, region_id) JOIN profiles pr ON pr.id = u.profile_id /* after join */
+}
+
+func TestSelectCrossAndNaturalJoin(t *testing.T) {
+	a := assert.New(t)
+	sb := NewSelectBuilder()
+	sb.Select("*").From("a")
+	sb.JoinWithOption(CrossJoin, "b", "a.id = b.id")
+	sb.JoinWithOption(NaturalJoin, "c")
+	sb.JoinWithOption(NaturalLeftJoin, "d", "ignored")
+	sb.JoinWithOption(NaturalRightJoin, "e")
+	sb.JoinWithOption(LeftJoin, "f", "f.id = a.id")
+
+	a.Equal(sb.String(), "SELECT * FROM a CROSS JOIN b NATURAL JOIN c NATURAL LEFT JOIN d NATURAL RIGHT JOIN e LEFT JOIN f ON f.id = a.id")
+}
+
+func ExampleSelectBuilder_AsCountQuery() {
+	sb := NewSelectBuilder()
+	sb.Select("user_id", "SUM(amount)").From("orders")
+	sb.Where(sb.GreaterThan("amount", 10))
+	sb.GroupBy("user_id")
+
+	cb := sb.AsCountQuery()
+
+	sql, args := cb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT COUNT(*) FROM (SELECT user_id, SUM(amount) FROM orders WHERE amount > ? GROUP BY user_id) AS t
+	// [10]
+}
+
+func TestSelectCount(t *testing.T) {
+	a := assert.New(t)
+	sb := NewSelectBuilder()
+	sb.Select("id", "name").From("user").Join("org", "org.id = user.org_id")
+	sb.Where(sb.Equal("org.name", "x"))
+
+	a.Equal(sb.Count("").String(), "SELECT COUNT(*) FROM user JOIN org ON org.id = user.org_id WHERE org.name = ?")
+	a.Equal(sb.Count("user.id").String(), "SELECT COUNT(user.id) FROM user JOIN org ON org.id = user.org_id WHERE org.name = ?")
+	a.Equal(sb.CountDistinct("user.name", "user.age").String(), "SELECT COUNT(DISTINCT user.name, user.age) FROM user JOIN org ON org.id = user.org_id WHERE org.name = ?")
+}
+
+func ExampleSelectBuilder_TableSample() {
+	sb := NewSelectBuilder()
+	sb.Select("*").From(sb.TableSample("events e", Bernoulli, 10))
+	sb.Where("e.kind = 'click'")
+
+	fmt.Println(sb.BuildWithFlavor(PostgreSQL))
+	fmt.Println(sb.BuildWithFlavor(Oracle))
+
+	// Output:
+	// SELECT * FROM events e TABLESAMPLE BERNOULLI (10) WHERE e.kind = 'click' []
+	// SELECT * FROM events SAMPLE (10) e WHERE e.kind = 'click' []
+}
+
+func TestSelectTableSample(t *testing.T) {
+	a := assert.New(t)
+	sb := NewSelectBuilder()
+	sb.Select("*").From(sb.TableSample("events", System, 0.5))
+
+	cases := map[Flavor]string{
+		PostgreSQL: "SELECT * FROM events TABLESAMPLE SYSTEM (0.5)",
+		SQLServer:  "SELECT * FROM events TABLESAMPLE SYSTEM (0.5 PERCENT)",
+		Oracle:     "SELECT * FROM events SAMPLE BLOCK (0.5)",
+		DuckDB:     "SELECT * FROM events TABLESAMPLE SYSTEM (0.5%)",
+		MySQL:      "SELECT * FROM events",
+	}
+
+	for flavor, expected := range cases {
+		sql, _ := sb.BuildWithFlavor(flavor)
+		a.Equal(sql, expected)
+	}
+
+	_, _, err := sb.BuildWithFlavorStrict(DuckDB)
+	a.NilError(err)
+	_, _, err = sb.BuildWithFlavorStrict(MySQL)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+}
+
+func ExampleSelectBuilder_FromWithHint() {
+	sb := NewSelectBuilder()
+	sb.Select("u.id", "o.id")
+	sb.FromWithHint("users u", UseIndex("idx_created_at"))
+	sb.JoinWithHint(LeftJoin, "orders o", ForceIndex("idx_user_id", "idx_status"), "o.user_id = u.id")
+
+	fmt.Println(sb)
+
+	// Output:
+	// SELECT u.id, o.id FROM users u USE INDEX (idx_created_at) LEFT JOIN orders o FORCE INDEX (idx_user_id, idx_status) ON o.user_id = u.id
+}
+
+func TestSelectIndexHint(t *testing.T) {
+	a := assert.New(t)
+	sb := NewSelectBuilder()
+	sb.Select("*").FromWithHint("users", IgnoreIndex("idx"))
+	sb.JoinWithHint("", "orders", UseIndex("idx_user_id"), "orders.user_id = users.id")
+
+	sql, _ := sb.BuildWithFlavor(SQLServer)
+	a.Equal(sql, "SELECT * FROM users JOIN orders WITH (INDEX(idx_user_id)) ON orders.user_id = users.id")
+
+	sql, _ = sb.BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "SELECT * FROM users JOIN orders ON orders.user_id = users.id")
+}
+
+func ExampleSelectBuilder_LimitVar() {
+	sb := NewSelectBuilder()
+	sb.Select("*").From("user").Where(sb.GreaterThan("level", 10))
+	sb.LimitVar(20).OffsetVar(40)
+
+	sql, args := sb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT * FROM user WHERE level > ? LIMIT ? OFFSET ?
+	// [10 20 40]
+}
+
+func TestSelectBuilderLimitVar(t *testing.T) {
+	a := assert.New(t)
+	cases := []struct {
+		flavor Flavor
+		sql    string
+		args   []interface{}
+	}{
+		{PostgreSQL, "SELECT * FROM t LIMIT $1 OFFSET $2", []interface{}{10, 5}},
+		{SQLServer, "SELECT * FROM t ORDER BY 1 OFFSET @p1 ROWS FETCH NEXT @p2 ROWS ONLY", []interface{}{5, 10}},
+		{Informix, "SELECT * FROM t SKIP ? FIRST ?", []interface{}{5, 10}},
+	}
+
+	for _, c := range cases {
+		sb := c.flavor.NewSelectBuilder()
+		sb.Select("*").From("t").LimitVar(10).OffsetVar(5)
+		sql, args := sb.Build()
+		a.Equal(sql, c.sql)
+		a.Equal(args, c.args)
+	}
+
+	sb := Oracle.NewSelectBuilder()
+	sb.Select("id").From("t").LimitVar(10).OffsetVar(5)
+	sql, args := sb.Build()
+	a.Equal(sql, "SELECT id FROM ( SELECT ROWNUM r, id FROM ( SELECT id FROM t ) t ) WHERE r BETWEEN :1 + 1 AND :2 + :3")
+	a.Equal(args, []interface{}{5, 10, 5})
+
+	// Limit resets the bound limit.
+	sb = NewSelectBuilder()
+	sb.Select("*").From("t").LimitVar(10).Limit(3)
+	sql, args = sb.Build()
+	a.Equal(sql, "SELECT * FROM t LIMIT 3")
+	a.Equal(len(args), 0)
+}
+
+func ExampleSelectBuilder_SubQuery() {
+	sb := PostgreSQL.NewSelectBuilder()
+	minAmount := sb.Var(100)
+
+	sub := sb.SubQuery()
+	sub.Select("1").From("orders o").Where(
+		"o.user_id = u.id",
+		"o.amount > "+minAmount,
+		sub.Equal("o.state", "paid"),
+	)
+
+	sb.Select("u.id").From("users u").Where(
+		sb.ExistsQuery(sub),
+		"u.balance > "+minAmount,
+	)
+
+	sql, args := sb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT u.id FROM users u WHERE EXISTS (SELECT 1 FROM orders o WHERE o.user_id = u.id AND o.amount > $1 AND o.state = $2) AND u.balance > $3
+	// [100 paid 100]
+}
+
+func TestSelectBuilderSubQuery(t *testing.T) {
+	a := assert.New(t)
+	sb := newSelectBuilder()
+	v := sb.Var(1)
+
+	sub := sb.SubQuery()
+	a.Equal(sub.Flavor(), sb.Flavor())
+	sub.Select("id").From("t2").Where("t2.v = " + v)
+
+	sb.Select("*").From("t1").Where(sb.InQuery("id", sub), "t1.v = "+v)
+	sql, args := sb.Build()
+	a.Equal(sql, "SELECT * FROM t1 WHERE id IN (SELECT id FROM t2 WHERE t2.v = ?) AND t1.v = ?")
+	a.Equal(args, []interface{}{1, 1})
+
+	// The sub query can be built alone.
+	sql, args = sub.Build()
+	a.Equal(sql, "SELECT id FROM t2 WHERE t2.v = ?")
+	a.Equal(args, []interface{}{1})
+}
+
+func ExampleSelectBuilder_SQLf() {
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("id").From("user")
+	sb.SQLf("TABLESAMPLE BERNOULLI ($?) REPEATABLE ($?)", 10, 42)
+	sb.Where(sb.Equal("status", 1))
+
+	sql, args := sb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT id FROM user TABLESAMPLE BERNOULLI ($1) REPEATABLE ($2) WHERE status = $3
+	// [10 42 1]
+}
+
+func ExampleSelectBuilder_JoinLateral() {
+	sub := Select("o.amount").From("orders o")
+	sub.Where("o.user_id = u.id").OrderBy("o.created_at").Desc().Limit(1)
+
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("u.id", "last.amount").From("users u")
+	sb.JoinLateral(LeftOuterJoin, sub, "last")
+
+	fmt.Println(sb)
+
+	// Output:
+	// SELECT u.id, last.amount FROM users u LEFT OUTER JOIN LATERAL (SELECT o.amount FROM orders o WHERE o.user_id = u.id ORDER BY o.created_at DESC LIMIT 1) AS last ON TRUE
+}
+
+func TestSelectBuilderJoinLateral(t *testing.T) {
+	a := assert.New(t)
+	sub := Select("*").From("t2").Where("t2.id = t1.id")
+
+	sb := Select("*").From("t1")
+	sb.JoinLateral(InnerJoin, sub, "x", "x.v > 0")
+	a.Equal(sb.String(), "SELECT * FROM t1 INNER JOIN LATERAL (SELECT * FROM t2 WHERE t2.id = t1.id) AS x ON x.v > 0")
+
+	sb = Select("*").From("t1")
+	sb.JoinLateral(CrossJoin, sub, "x")
+	a.Equal(sb.String(), "SELECT * FROM t1 CROSS JOIN LATERAL (SELECT * FROM t2 WHERE t2.id = t1.id) AS x")
+
+	_, _, err := sb.BuildWithFlavorStrict(PostgreSQL)
+	a.NilError(err)
+	_, _, err = sb.BuildWithFlavorStrict(SQLite)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+}
@@ -0,0 +1,170 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidArg means that a placeholder in the SQL cannot be resolved to an arg.
+	// It usually happens when an expression built by a Cond is used in a builder not sharing the Cond's Args.
+	ErrInvalidArg = errors.New("go-sqlbuilder: invalid arg")
+
+	// ErrUnsupportedFlavor means that a clause set in the builder is not supported by the flavor
+	// and would be omitted in the SQL.
+	ErrUnsupportedFlavor = errors.New("go-sqlbuilder: clause is not supported by the flavor")
+
+	// ErrColumnCountMismatch means that the number of columns in INSERT differs from that in SELECT
+	// in an INSERT ... SELECT statement.
+	ErrColumnCountMismatch = errors.New("go-sqlbuilder: column count mismatch")
+
+	// ErrDistinctOnMismatch means that DISTINCT ON expressions don't match the leading ORDER BY expressions,
+	// which is required by PostgreSQL.
+	ErrDistinctOnMismatch = errors.New("go-sqlbuilder: DISTINCT ON expressions must match leading ORDER BY expressions")
+)
+
+const invalidArgPrefix = "/* INVALID ARG $"
+
+// flavorChecker is implemented by builders which may omit clauses unsupported by a flavor.
+type flavorChecker interface {
+	// checkFlavor returns an error wrapping ErrUnsupportedFlavor if any clause would be omitted in flavor,
+	// or another error if the statement is invalid in flavor.
+	checkFlavor(flavor Flavor) error
+}
+
+// validator is implemented by builders which can detect invalid statements regardless of flavor.
+type validator interface {
+	// validate returns an error if the statement is invalid.
+	validate() error
+}
+
+// exprGroup is a group of expressions in a clause sharing the same args.
+type exprGroup struct {
+	clause string
+	args   *Args
+	exprs  []string
+}
+
+// exprLister is implemented by builders which can list expressions to locate an invalid arg.
+type exprLister interface {
+	listExprs() []exprGroup
+}
+
+// buildStrict builds b with flavor and initialArg and validates the result.
+// It returns an error if any placeholder cannot be resolved, any clause is not supported by the flavor
+// or the statement is invalid.
+func buildStrict(b Builder, flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}, err error) {
+	if checker, ok := b.(flavorChecker); ok {
+		if err = checker.checkFlavor(flavor); err != nil {
+			return
+		}
+	}
+
+	if v, ok := b.(validator); ok {
+		if err = v.validate(); err != nil {
+			return
+		}
+	}
+
+	sql, args = b.BuildWithFlavor(flavor, initialArg...)
+
+	if idx := strings.Index(sql, invalidArgPrefix); idx >= 0 {
+		ref := sql[idx+len(invalidArgPrefix):]
+
+		if end := strings.IndexByte(ref, ' '); end >= 0 {
+			ref = ref[:end]
+		}
+
+		err = fmt.Errorf("%w: $%s", ErrInvalidArg, ref)
+
+		if lister, ok := b.(exprLister); ok {
+			if clause, expr, ref, ok := findInvalidArgExpr(lister.listExprs()); ok {
+				err = fmt.Errorf("%w: %s in %s expression %q", ErrInvalidArg, ref, clause, expr)
+			}
+		}
+
+		return "", nil, err
+	}
+
+	return
+}
+
+// findInvalidArgExpr returns the first expression referring an arg which doesn't exist in its args.
+func findInvalidArgExpr(groups []exprGroup) (clause, expr, ref string, ok bool) {
+	for _, g := range groups {
+		if g.args == nil {
+			continue
+		}
+
+		for _, expr = range g.exprs {
+			if ref, ok = g.args.findInvalidRef(expr); ok {
+				clause = g.clause
+				return
+			}
+		}
+	}
+
+	return "", "", "", false
+}
+
+// clauseExprGroups returns expressions in clauses with the args of each clause.
+func clauseExprGroups(name string, clauses []clause) []exprGroup {
+	groups := make([]exprGroup, 0, len(clauses))
+
+	for _, c := range clauses {
+		groups = append(groups, exprGroup{
+			clause: name,
+			args:   c.args,
+			exprs:  c.andExprs,
+		})
+	}
+
+	return groups
+}
+
+// findInvalidRef returns the first "$n" in format which cannot be resolved in args.
+// The syntax of format is the same as `Args#Compile`.
+func (args *Args) findInvalidRef(format string) (ref string, ok bool) {
+	for idx := strings.IndexByte(format, '$'); idx >= 0; idx = strings.IndexByte(format, '$') {
+		format = format[idx+1:]
+
+		if len(format) == 0 {
+			break
+		}
+
+		if format[0] == '$' {
+			format = format[1:]
+			continue
+		}
+
+		i := 0
+
+		for ; i < len(format) && '0' <= format[i] && format[i] <= '9'; i++ {
+			// Nothing.
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		digits := format[:i]
+		format = format[i:]
+
+		if pointer, err := strconv.Atoi(digits); err == nil {
+			if offset := pointer - args.indexBase; offset < 0 || offset >= len(args.argValues) {
+				return "$" + digits, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func unsupportedFlavorError(clause string, flavor Flavor) error {
+	return fmt.Errorf("%w: %s in %v", ErrUnsupportedFlavor, clause, flavor)
+}
@@ -0,0 +1,241 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleSelectBuilder_BuildWithFlavorStrict() {
+	cond := NewCond()
+	sb := Select("*").From("t1")
+	sb.Where(cond.Equal("a", 123))
+
+	_, _, err := sb.BuildWithFlavorStrict(MySQL)
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, ErrInvalidArg))
+
+	// Output:
+	// go-sqlbuilder: invalid arg: $256 in WHERE expression "$256"
+	// true
+}
+
+func TestBuildWithFlavorStrict(t *testing.T) {
+	a := assert.New(t)
+
+	sb := Select("*").From("t1")
+	sb.Where(sb.Equal("a", 123)).ForUpdate().SkipLocked()
+	sql, args, err := sb.BuildWithFlavorStrict(PostgreSQL)
+	a.NilError(err)
+	a.Equal(sql, "SELECT * FROM t1 WHERE a = $1 FOR UPDATE SKIP LOCKED")
+	a.Equal(args, []interface{}{123})
+
+	_, _, err = sb.BuildWithFlavorStrict(SQLite)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+	a.Equal(err.Error(), "go-sqlbuilder: clause is not supported by the flavor: FOR UPDATE SKIP LOCKED in SQLite")
+
+	sb = Select("*").From("t1").Offset(10)
+	_, _, err = sb.BuildWithFlavorStrict(CQL)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+	_, _, err = sb.BuildWithFlavorStrict(Informix)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+	_, _, err = sb.BuildWithFlavorStrict(MySQL)
+	a.NilError(err)
+
+	sb = Select("*").From("t1").ForSystemTimeAsOf("2024-01-01")
+	_, _, err = sb.BuildWithFlavorStrict(PostgreSQL)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+	_, _, err = sb.BuildWithFlavorStrict(MariaDB)
+	a.NilError(err)
+
+	sb = Select("a", "b").DistinctOn("a").From("t1")
+	_, _, err = sb.BuildWithFlavorStrict(MySQL)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+	_, _, err = sb.BuildWithFlavorStrict(DuckDB)
+	a.NilError(err)
+
+	sb = Select("*").From("t1").OrderByCol("a", Descending, NullsLast)
+	_, _, err = sb.BuildWithFlavorStrict(CQL)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+	_, _, err = sb.BuildWithFlavorStrict(MySQL)
+	a.NilError(err)
+
+	ib := InsertInto("t1").Cols("id").Values(1).Returning("id")
+	_, _, err = ib.BuildWithFlavorStrict(MySQL)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+	_, _, err = ib.BuildWithFlavorStrict(SQLServer)
+	a.NilError(err)
+
+	ib = InsertInto("t1").Cols("id").Values(1)
+	ib.OnConflict("id").DoNothing()
+	sql, _, err = ib.BuildWithFlavorStrict(MySQL)
+	a.NilError(err)
+	a.Equal(sql, "INSERT IGNORE INTO t1 (id) VALUES (?)")
+	_, _, err = ib.BuildWithFlavorStrict(Oracle)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+	_, _, err = ib.BuildWithFlavorStrict(PostgreSQL)
+	a.NilError(err)
+
+	ib = ReplaceInto("t1").Cols("id").Values(1)
+	ib.OnConflict("id").DoNothing()
+	_, _, err = ib.BuildWithFlavorStrict(MySQL)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+
+	db := DeleteFrom("t1").Returning("id")
+	_, _, err = db.BuildWithFlavorStrict(SQLServer)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+
+	ib = InsertInto("t1").Cols("id").Values(1).OverridingSystemValue()
+	_, _, err = ib.BuildWithFlavorStrict(SQLServer)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+	_, _, err = ib.BuildWithFlavorStrict(PostgreSQL)
+	a.NilError(err)
+
+	ub := Update("t1").Set("a = 1").Returning("*")
+	_, _, err = ub.BuildWithFlavorStrict(MariaDB)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+	_, _, err = ub.BuildWithFlavorStrict(SQLite)
+	a.NilError(err)
+
+	ub = Update("t1").Set("a = b.a").Join("t2 b", "b.id = t1.id")
+	_, _, err = ub.BuildWithFlavorStrict(PostgreSQL)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+	_, _, err = ub.BuildWithFlavorStrict(MySQL)
+	a.NilError(err)
+	ub.From("t3")
+	_, _, err = ub.BuildWithFlavorStrict(PostgreSQL)
+	a.NilError(err)
+
+	ub = Update("t1").Set("a = t2.a").From("t2").Where("t1.id = t2.id")
+	_, _, err = ub.BuildWithFlavorStrict(Oracle)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+	_, _, err = ub.BuildWithFlavorStrict(ClickHouse)
+	a.Assert(errors.Is(err, ErrUnsupportedFlavor))
+	_, _, err = ub.BuildWithFlavorStrict(SQLServer)
+	a.NilError(err)
+
+	cond := NewCond()
+	ub = Update("t1").Set("a = 1").Where(cond.Equal("b", 2))
+	sql, args, err = ub.BuildWithFlavorStrict(MySQL)
+	a.Assert(errors.Is(err, ErrInvalidArg))
+	a.Equal(sql, "")
+	a.Equal(len(args), 0)
+}
+
+func ExampleSelectBuilder_BuildE() {
+	cond := NewCond()
+	sb := Select("*").From("t1")
+	sb.Where(sb.GreaterThan("id", 10), "status = "+cond.Var(1))
+
+	_, _, err := sb.BuildE()
+	fmt.Println(err)
+
+	// Output:
+	// go-sqlbuilder: invalid arg: $256 in WHERE expression "status = $256"
+}
+
+func TestBuildE(t *testing.T) {
+	a := assert.New(t)
+	cond := NewCond()
+
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("id", "name").From("t1").Where(sb.Equal("id", 1))
+	sql, args, err := sb.BuildE()
+	a.NilError(err)
+	a.Equal(sql, "SELECT id, name FROM t1 WHERE id = $1")
+	a.Equal(args, []interface{}{1})
+
+	sb.OrderBy(cond.Var(Raw("name")))
+	_, _, err = sb.BuildE()
+	a.Equal(err.Error(), `go-sqlbuilder: invalid arg: $256 in ORDER BY expression "$256"`)
+
+	ub := Update("t1")
+	ub.Set(ub.Assign("a", 1), "b = "+cond.Var(2))
+	_, _, err = ub.BuildE()
+	a.Equal(err.Error(), `go-sqlbuilder: invalid arg: $257 in SET expression "b = $257"`)
+
+	db := DeleteFrom("t1")
+	db.Where(cond.Equal("a", 1))
+	_, _, err = db.BuildE()
+	a.Assert(errors.Is(err, ErrInvalidArg))
+
+	ib := InsertInto("t1").Cols("a", "b")
+	ib.Values(1, 2)
+	sql, _, err = ib.BuildE()
+	a.NilError(err)
+	a.Equal(sql, "INSERT INTO t1 (a, b) VALUES (?, ?)")
+
+	// The invalid arg in a nested builder is reported without expression.
+	sub := Select("id").From("t2").Where(cond.Equal("x", 1))
+	sb = Select("*").From("t1")
+	sb.Where(sb.InQuery("id", sub))
+	_, _, err = sb.BuildE()
+	a.Equal(err.Error(), "go-sqlbuilder: invalid arg: $259")
+}
+
+func TestArgsFindInvalidRef(t *testing.T) {
+	a := assert.New(t)
+	args := &Args{}
+	args.Add(1)
+
+	ref, ok := args.findInvalidRef("a = $0 AND b = $$1 AND c = ${name} AND d = $?")
+	a.Assert(!ok)
+	a.Equal(ref, "")
+
+	ref, ok = args.findInvalidRef("a = $0 AND b = $1")
+	a.Assert(ok)
+	a.Equal(ref, "$1")
+}
+
+func ExampleInsertBuilder_BuildE() {
+	ib := InsertInto("users").Cols("id", "name", "created_at")
+	ib.Select("id", "name").From("old_users")
+
+	_, _, err := ib.BuildE()
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, ErrColumnCountMismatch))
+
+	// Output:
+	// go-sqlbuilder: column count mismatch: 3 columns in INSERT but 2 columns in SELECT
+	// true
+}
+
+func TestInsertBuilderSelectColumnCount(t *testing.T) {
+	a := assert.New(t)
+	cases := []struct {
+		cols       []string
+		selectCols []string
+		err        error
+	}{
+		{[]string{"a", "b"}, []string{"a", "b"}, nil},
+		{[]string{"a", "b"}, []string{"a"}, ErrColumnCountMismatch},
+		{[]string{"a", "b"}, []string{"a", "b", "c"}, ErrColumnCountMismatch},
+		{[]string{"a", "b"}, []string{"*"}, nil},
+		{[]string{"a", "b"}, []string{"t.*", "c"}, nil},
+		{[]string{"a", "b"}, nil, nil},
+		{nil, []string{"a"}, nil},
+	}
+
+	for _, c := range cases {
+		ib := InsertInto("t").Cols(c.cols...)
+		ib.Select(c.selectCols...).From("t2")
+		_, _, err := ib.BuildE()
+		a.Use(&c)
+
+		if c.err == nil {
+			a.NilError(err)
+		} else {
+			a.Assert(errors.Is(err, c.err))
+		}
+	}
+
+	// Build is not affected by the validation.
+	ib := InsertInto("t").Cols("a", "b")
+	ib.Select("a").From("t2")
+	a.Equal(ib.String(), "INSERT INTO t (a, b) SELECT a FROM t2")
+}
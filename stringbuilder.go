@@ -72,6 +72,10 @@ func (sb *stringBuilder) Reset() {
 	sb.builder.Reset()
 }
 
+func (sb *stringBuilder) Len() int {
+	return sb.builder.Len()
+}
+
 func (sb *stringBuilder) Grow(n int) {
 	sb.builder.Grow(n)
 }
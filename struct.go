@@ -4,12 +4,14 @@
 package sqlbuilder
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"math"
 	"reflect"
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 )
 
 var (
@@ -26,11 +28,25 @@ var (
 
 	// FieldAs is the column alias (AS) for a struct field.
 	FieldAs = "fieldas"
+
+	// FieldType is the column type for a struct field, e.g. "VARCHAR(255)".
+	// It's used by `Struct#CreateTable` and written as it is.
+	FieldType = "fieldtype"
+
+	// PrimaryKeyTag is the tag in FieldTag to mark primary key fields.
+	// It's used by `Struct#WhereForPrimaryKey`.
+	PrimaryKeyTag = "pk"
 )
 
 const (
 	fieldOptWithQuote = "withquote"
 	fieldOptOmitEmpty = "omitempty"
+	fieldOptJoin      = "join"
+
+	fieldOptNotNull       = "notnull"
+	fieldOptPrimaryKey    = "pk"
+	fieldOptUnique        = "unique"
+	fieldOptAutoIncrement = "autoincrement"
 
 	optName   = "optName"
 	optParams = "optParams"
@@ -279,7 +295,39 @@ func removeDuplicatedTags(tags []string) []string {
 //
 // Caller is responsible to set WHERE condition to find right record.
 func (s *Struct) SelectFrom(table string) *SelectBuilder {
-	return s.selectFromWithTags(table, s.withTags, s.withoutTags)
+	return s.selectFromWithTags(table, s.withTags, s.withoutTags, false, "")
+}
+
+// SelectFromWithJoins creates a new `SelectBuilder` with table name like `SelectFrom`.
+// Unlike `SelectFrom`, fields with a join option in `fieldopt` tag are selected from the joined table,
+// and the JOIN clause is added automatically.
+//
+// The join option is in the form of `join(table:onExpr)`.
+// If more than one field is in the same joined table, the onExpr can be omitted in all but one of them.
+//
+//	type UserWithProfile struct {
+//	    ID     int    `db:"id"`
+//	    Name   string `db:"name"`
+//	    Avatar string `db:"avatar" fieldopt:"join(profiles p:p.user_id = u.id)"`
+//	    Bio    string `db:"bio" fieldopt:"join(profiles p)"`
+//	}
+//
+//	sb := NewStruct(new(UserWithProfile)).SelectFromWithJoins("users u")
+//	// SELECT u.id, u.name, p.avatar, p.bio FROM users u JOIN profiles p ON p.user_id = u.id
+//
+// Caller is responsible to set WHERE condition to find right record.
+func (s *Struct) SelectFromWithJoins(table string) *SelectBuilder {
+	return s.selectFromWithTags(table, s.withTags, s.withoutTags, true, "")
+}
+
+// SelectFromWithAlias creates a new `SelectBuilder` with table name like `SelectFrom`.
+// Unlike `SelectFrom`, every column is aliased with prefix in the form of "table.col AS prefix_col",
+// so that columns with the same name in different tables are not ambiguous in a JOIN.
+// If a field has an alias set by `fieldas`, the alias is prefixed instead of the column name.
+//
+// Caller is responsible to set WHERE condition to find right record.
+func (s *Struct) SelectFromWithAlias(table, prefix string) *SelectBuilder {
+	return s.selectFromWithTags(table, s.withTags, s.withoutTags, false, prefix)
 }
 
 // SelectFromForTag creates a new `SelectBuilder` with table name for a specified tag.
@@ -290,10 +338,10 @@ func (s *Struct) SelectFrom(table string) *SelectBuilder {
 // Deprecated: It's recommended to use s.WithTag(tag).SelectFrom(...) instead of calling this method.
 // The former one is more readable and can be chained with other methods.
 func (s *Struct) SelectFromForTag(table string, tag string) (sb *SelectBuilder) {
-	return s.selectFromWithTags(table, []string{tag}, nil)
+	return s.selectFromWithTags(table, []string{tag}, nil, false, "")
 }
 
-func (s *Struct) selectFromWithTags(table string, with, without []string) (sb *SelectBuilder) {
+func (s *Struct) selectFromWithTags(table string, with, without []string, withJoins bool, aliasPrefix string) (sb *SelectBuilder) {
 	sfs := s.structFieldsParser()
 	tagged := sfs.FilterTags(with, without)
 
@@ -309,18 +357,50 @@ func (s *Struct) selectFromWithTags(table string, with, without []string) (sb *S
 	cols := make([]string, 0, len(tagged.ForRead))
 	tableAlias := parseTableAlias(table)
 
+	var joinTables []string
+	joinOnExprs := map[string]string{}
+
 	for _, sf := range tagged.ForRead {
+		alias := tableAlias
+
+		if withJoins && sf.JoinTable != "" {
+			alias = parseTableAlias(sf.JoinTable)
+
+			if on, ok := joinOnExprs[sf.JoinTable]; !ok {
+				joinTables = append(joinTables, sf.JoinTable)
+				joinOnExprs[sf.JoinTable] = sf.JoinOn
+			} else if on == "" {
+				joinOnExprs[sf.JoinTable] = sf.JoinOn
+			}
+		}
+
 		if s.Flavor != CQL && !strings.ContainsRune(sf.Alias, '.') {
-			buf.WriteString(tableAlias)
+			buf.WriteString(alias)
 			buf.WriteRune('.')
 		}
-		buf.WriteString(sf.NameForSelect(s.Flavor))
+
+		if aliasPrefix == "" {
+			buf.WriteString(sf.NameForSelect(s.Flavor))
+		} else {
+			buf.WriteString(sf.Quote(s.Flavor))
+			buf.WriteString(" AS ")
+			buf.WriteString(sf.aliasWithPrefix(s.Flavor, aliasPrefix))
+		}
 
 		cols = append(cols, buf.String())
 		buf.Reset()
 	}
 
 	sb.Select(cols...)
+
+	for _, joinTable := range joinTables {
+		if on := joinOnExprs[joinTable]; on != "" {
+			sb.Join(joinTable, on)
+		} else {
+			sb.Join(joinTable)
+		}
+	}
+
 	return sb
 }
 
@@ -374,8 +454,44 @@ func (s *Struct) updateWithTags(table string, with, without []string, value inte
 	}
 
 	assignments := make([]string, 0, len(tagged.ForWrite))
+	s.foreachValueWithFields(tagged.ForWrite, with, v, func(sf *structField, data interface{}) {
+		assignments = append(assignments, ub.Assign(sf.Quote(s.Flavor), data))
+	})
 
-	for _, sf := range tagged.ForWrite {
+	ub.Set(assignments...)
+	return ub
+}
+
+// ForEachValue iterates all exported fields tagged with tag in value and calls fn with
+// column name and field value. If tag is empty, all fields selected by `WithTag` and `WithoutTag` are iterated.
+//
+// Field values are extracted in the same way as `Update`.
+// Empty fields with `omitempty` option are skipped and pointers are dereferenced
+// unless the field implements `driver.Valuer`.
+// If value's type is not the same as that of s, fn is never called.
+func (s *Struct) ForEachValue(value interface{}, tag string, fn func(col string, isQuoted bool, v interface{})) {
+	st := s.WithTag(tag)
+	sfs := st.structFieldsParser()
+	tagged := sfs.FilterTags(st.withTags, st.withoutTags)
+
+	if tagged == nil {
+		return
+	}
+
+	v := reflect.ValueOf(value)
+	v = dereferencedValue(v)
+
+	if v.Type() != s.structType {
+		return
+	}
+
+	s.foreachValueWithFields(tagged.ForWrite, st.withTags, v, func(sf *structField, data interface{}) {
+		fn(sf.Alias, sf.IsQuoted, data)
+	})
+}
+
+func (s *Struct) foreachValueWithFields(fields []*structField, with []string, v reflect.Value, fn func(sf *structField, data interface{})) {
+	for _, sf := range fields {
 		name := sf.Name
 		val := v.FieldByName(name)
 
@@ -387,12 +503,8 @@ func (s *Struct) updateWithTags(table string, with, without []string, value inte
 			val = dereferencedFieldValue(val)
 		}
 
-		data := val.Interface()
-		assignments = append(assignments, ub.Assign(sf.Quote(s.Flavor), data))
+		fn(sf, val.Interface())
 	}
-
-	ub.Set(assignments...)
-	return ub
 }
 
 // InsertInto creates a new `InsertBuilder` with table name using verb INSERT INTO.
@@ -440,6 +552,56 @@ func (s *Struct) ReplaceInto(table string, value ...interface{}) *InsertBuilder
 	return ib
 }
 
+// UpsertInto creates a new `InsertBuilder` with table name using verb INSERT INTO
+// and sets all inserted columns except conflictCols to the proposed values on conflict.
+// Columns and values are set in the same way as `InsertInto`.
+//
+// In PostgreSQL and SQLite, it's written as "ON CONFLICT (conflictCols...) DO UPDATE SET col = EXCLUDED.col...".
+// In MySQL, it's written as "ON DUPLICATE KEY UPDATE col = VALUES(col)...".
+// If all inserted columns are in conflictCols, the action is DO NOTHING.
+// See `InsertBuilder#OnConflict` for details.
+//
+// UpsertInto never returns any error.
+// If the type of any item in value is not expected, it will be ignored.
+// If value is an empty slice, no upsert clause is set.
+func (s *Struct) UpsertInto(table string, conflictCols []string, value ...interface{}) *InsertBuilder {
+	ib := s.Flavor.NewInsertBuilder()
+	ib.InsertInto(table)
+
+	s.buildColsAndValuesForTag(ib, s.withTags, s.withoutTags, value...)
+
+	if len(ib.cols) == 0 {
+		return ib
+	}
+
+	conflicts := make(map[string]struct{}, len(conflictCols))
+
+	for _, col := range conflictCols {
+		conflicts[col] = struct{}{}
+		conflicts[s.Flavor.Quote(col)] = struct{}{}
+	}
+
+	assignments := make([]string, 0, len(ib.cols))
+
+	for _, col := range ib.cols {
+		if _, ok := conflicts[col]; ok {
+			continue
+		}
+
+		assignments = append(assignments, col+" = "+ib.excluded(col))
+	}
+
+	oc := ib.OnConflict(conflictCols...)
+
+	if len(assignments) == 0 {
+		oc.DoNothing()
+	} else {
+		oc.DoUpdateSet(assignments...)
+	}
+
+	return ib
+}
+
 // buildColsAndValuesForTag uses ib to set exported fields tagged with tag as columns
 // and add value as a list of values.
 func (s *Struct) buildColsAndValuesForTag(ib *InsertBuilder, with, without []string, value ...interface{}) {
@@ -581,6 +743,102 @@ func (s *Struct) DeleteFrom(table string) *DeleteBuilder {
 	return db
 }
 
+// CreateTable creates a new `CreateTableBuilder` with table name and column definitions of all writable fields.
+//
+// The column type is set by the `fieldtype` tag and written as it is.
+// Without `fieldtype`, it's derived from the field type by a portable `ColumnType`,
+// e.g. int64 is `TypeBigInt`, string is `TypeVarchar(255)` and time.Time is `TypeTimestamp`.
+// If the field type cannot be mapped, the column is written without type.
+//
+// Constraints are set by options in the `fieldopt` tag, including "notnull", "pk", "unique" and "autoincrement".
+// A field tagged with `PrimaryKeyTag` in FieldTag is also a primary key.
+func (s *Struct) CreateTable(table string) *CreateTableBuilder {
+	ctb := s.Flavor.NewCreateTableBuilder()
+	ctb.CreateTable(table)
+
+	sfs := s.structFieldsParser()
+	tagged := sfs.FilterTags(s.withTags, s.withoutTags)
+
+	if tagged == nil {
+		return ctb
+	}
+
+	for _, sf := range tagged.ForWrite {
+		cd := ctb.Column(sf.Quote(s.Flavor))
+
+		if sf.Type != "" {
+			cd.Type(TypeRaw(sf.Type))
+		} else {
+			cd.Type(columnTypeOf(sf.Field.Type))
+		}
+
+		if sf.NotNull {
+			cd.NotNull()
+		}
+
+		if sf.PrimaryKey {
+			cd.PrimaryKey()
+		}
+
+		if sf.Unique {
+			cd.Unique()
+		}
+
+		if sf.AutoIncrement {
+			cd.AutoIncrement()
+		}
+	}
+
+	return ctb
+}
+
+// WhereForPrimaryKey creates a `WhereClause` matching all primary key columns
+// with the field values in value.
+// Primary key fields are the fields tagged with `PrimaryKeyTag` in FieldTag
+// or with the "pk" option in FieldOpt.
+//
+// If there is no primary key field in s or value's type is not the same as that of s,
+// WhereForPrimaryKey returns nil, which can be safely passed to `AddWhereClause`.
+func (s *Struct) WhereForPrimaryKey(value interface{}) *WhereClause {
+	sfs := s.structFieldsParser()
+	pkFields := make([]*structField, 0, 1)
+
+	for _, sf := range sfs.noTag.ForWrite {
+		if sf.PrimaryKey {
+			pkFields = append(pkFields, sf)
+		}
+	}
+
+	if len(pkFields) == 0 {
+		return nil
+	}
+
+	v := reflect.ValueOf(value)
+	v = dereferencedValue(v)
+
+	if !v.IsValid() || v.Type() != s.structType {
+		return nil
+	}
+
+	cond := NewCond()
+	exprs := make([]string, 0, len(pkFields))
+
+	for _, sf := range pkFields {
+		var data interface{}
+
+		if val := dereferencedFieldValue(v.FieldByName(sf.Name)); val.IsValid() {
+			data = val.Interface()
+		}
+
+		exprs = append(exprs, cond.Equal(sf.Quote(s.Flavor), data))
+	}
+
+	wc := NewWhereClause()
+	wc.SetFlavor(s.Flavor)
+	wc.AddWhereExpr(cond.Args, exprs...)
+	return wc
+}
+
 // Addr takes address of all exported fields of the s from the st.
 // The returned result can be used in `Row#Scan` directly.
 func (s *Struct) Addr(st interface{}) []interface{} {
@@ -748,6 +1006,56 @@ func (s *Struct) foreachWriteWithTags(with, without []string, trans func(dbtag s
 	}
 }
 
+var (
+	typeOfTime        = reflect.TypeOf(time.Time{})
+	typeOfBytes       = reflect.TypeOf([]byte(nil))
+	typeOfNullString  = reflect.TypeOf(sql.NullString{})
+	typeOfNullInt64   = reflect.TypeOf(sql.NullInt64{})
+	typeOfNullInt32   = reflect.TypeOf(sql.NullInt32{})
+	typeOfNullFloat64 = reflect.TypeOf(sql.NullFloat64{})
+	typeOfNullBool    = reflect.TypeOf(sql.NullBool{})
+	typeOfNullTime    = reflect.TypeOf(sql.NullTime{})
+)
+
+// columnTypeOf returns the portable column type of a field type.
+func columnTypeOf(t reflect.Type) ColumnType {
+	t = dereferencedType(t)
+
+	switch t {
+	case typeOfTime, typeOfNullTime:
+		return TypeTimestamp
+	case typeOfBytes:
+		return TypeBlob
+	case typeOfNullString:
+		return TypeVarchar(255)
+	case typeOfNullInt64:
+		return TypeBigInt
+	case typeOfNullInt32:
+		return TypeInt
+	case typeOfNullFloat64:
+		return TypeDouble
+	case typeOfNullBool:
+		return TypeBoolean
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return TypeBoolean
+	case reflect.Int8, reflect.Int16, reflect.Uint8:
+		return TypeSmallInt
+	case reflect.Int32, reflect.Uint16:
+		return TypeInt
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return TypeBigInt
+	case reflect.Float32, reflect.Float64:
+		return TypeDouble
+	case reflect.String:
+		return TypeVarchar(255)
+	}
+
+	return TypeRaw("")
+}
+
 func dereferencedType(t reflect.Type) reflect.Type {
 	for k := t.Kind(); k == reflect.Ptr || k == reflect.Interface; k = t.Kind() {
 		t = t.Elem()
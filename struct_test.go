@@ -4,6 +4,7 @@
 package sqlbuilder
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"fmt"
 	"reflect"
@@ -310,6 +311,55 @@ func TestStructForeachRead(t *testing.T) {
 	})
 }
 
+func TestStructForEachValue(t *testing.T) {
+	a := assert.New(t)
+	user := &structUserForTest{
+		ID:        123,
+		Name:      "Huan Du",
+		Status:    2,
+		CreatedAt: 1234567890,
+	}
+	var cols []string
+	var values []interface{}
+	collect := func(col string, isQuoted bool, v interface{}) {
+		cols = append(cols, col)
+		values = append(values, v)
+	}
+
+	userForTest.ForEachValue(user, "", collect)
+	a.Equal(cols, []string{"id", "Name", "status", "created_at"})
+	a.Equal(values, []interface{}{123, "Huan Du", 2, 1234567890})
+
+	cols, values = nil, nil
+	userForTest.ForEachValue(user, "important", collect)
+	a.Equal(cols, []string{"id", "Name", "status"})
+	a.Equal(values, []interface{}{123, "Huan Du", 2})
+
+	cols, values = nil, nil
+	userForTest.ForEachValue(123, "", collect)
+	a.Equal(len(cols), 0)
+
+	type structOmitEmpty struct {
+		A *int   `db:"a" fieldopt:"omitempty"`
+		B string `db:"b" fieldopt:"withquote"`
+	}
+	var quoted []bool
+	n := 1
+	NewStruct(new(structOmitEmpty)).ForEachValue(&structOmitEmpty{B: "b"}, "", func(col string, isQuoted bool, v interface{}) {
+		cols = append(cols, col)
+		quoted = append(quoted, isQuoted)
+		values = append(values, v)
+	})
+	a.Equal(cols, []string{"b"})
+	a.Equal(quoted, []bool{true})
+	a.Equal(values, []interface{}{"b"})
+
+	cols, values = nil, nil
+	NewStruct(new(structOmitEmpty)).ForEachValue(&structOmitEmpty{A: &n}, "", collect)
+	a.Equal(cols, []string{"a", "b"})
+	a.Equal(values, []interface{}{1, ""})
+}
+
 type State int
 type testDB int
 type testRows int
@@ -971,6 +1021,30 @@ func TestStructFieldAs(t *testing.T) {
 	a.Equal(sql, `UPDATE t SET t1 = ?, t2 = ?, t4 = ?`)
 }
 
+type structWithJoins struct {
+	ID     int    `db:"id"`
+	Name   string `db:"name" fieldtag:"basic"`
+	Avatar string `db:"avatar" fieldopt:"join(profiles p:p.user_id = u.id)"`
+	Bio    string `db:"bio" fieldopt:"join(profiles p)"`
+	Total  int    `db:"total" fieldas:"order_total" fieldopt:"join(order_stats:order_stats.user_id = u.id)"`
+}
+
+func TestStructSelectFromWithJoins(t *testing.T) {
+	a := assert.New(t)
+	s := NewStruct(new(structWithJoins))
+
+	sql, _ := s.SelectFromWithJoins("users u").Build()
+	a.Equal(sql, "SELECT u.id, u.name, p.avatar, p.bio, order_stats.total AS order_total FROM users u JOIN profiles p ON p.user_id = u.id JOIN order_stats ON order_stats.user_id = u.id")
+
+	// Fields not selected don't add JOIN.
+	sql, _ = s.WithTag("basic").SelectFromWithJoins("users u").Build()
+	a.Equal(sql, "SELECT u.name FROM users u")
+
+	// SelectFrom ignores join options.
+	sql, _ = s.SelectFrom("users u").Build()
+	a.Equal(sql, "SELECT u.id, u.name, u.avatar, u.bio, u.total AS order_total FROM users u")
+}
+
 type structImplValuer int
 
 func (v *structImplValuer) Value() (driver.Value, error) {
@@ -1039,3 +1113,186 @@ func ExampleFieldMapperFunc() {
 	// SELECT orders.id, orders.user_id, orders.product_name, orders.status, orders.user_addr_line1, orders.user_addr_line2, orders.created_at FROM orders LIMIT 10
 	// true
 }
+
+func ExampleStruct_WhereForPrimaryKey() {
+	type Order struct {
+		UserID  int64  `db:"user_id" fieldtag:"pk"`
+		OrderID int64  `db:"order_id" fieldtag:"pk"`
+		State   string `db:"state"`
+	}
+
+	var orderStruct = NewStruct(new(Order))
+	order := &Order{
+		UserID:  1234,
+		OrderID: 5678,
+		State:   "paid",
+	}
+
+	ub := orderStruct.Update("orders", order)
+	ub.AddWhereClause(orderStruct.WhereForPrimaryKey(order))
+
+	sql, args := ub.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// UPDATE orders SET user_id = ?, order_id = ?, state = ? WHERE user_id = ? AND order_id = ?
+	// [1234 5678 paid 1234 5678]
+}
+
+func TestStructWhereForPrimaryKey(t *testing.T) {
+	a := assert.New(t)
+	type User struct {
+		ID   int64  `db:"id" fieldtag:"pk"`
+		Name string `db:"name"`
+	}
+	st := NewStruct(new(User)).For(PostgreSQL)
+
+	wc := st.WhereForPrimaryKey(&User{ID: 42})
+	a.Assert(wc != nil)
+
+	db := st.DeleteFrom("users")
+	db.AddWhereClause(wc)
+	sql, args := db.Build()
+	a.Equal(sql, "DELETE FROM users WHERE id = $1")
+	a.Equal(args, []interface{}{int64(42)})
+
+	a.Assert(st.WhereForPrimaryKey(structContainsValuer{}) == nil)
+	a.Assert(NewStruct(new(structContainsValuer)).WhereForPrimaryKey(structContainsValuer{}) == nil)
+
+	// A primary key can also be marked by the "pk" option in FieldOpt.
+	type Order struct {
+		UserID  int64  `db:"user_id" fieldopt:"pk"`
+		OrderID int64  `db:"order_id" fieldtag:"pk"`
+		State   string `db:"state"`
+	}
+	orderStruct := NewStruct(new(Order))
+	db = orderStruct.DeleteFrom("orders")
+	db.AddWhereClause(orderStruct.WhereForPrimaryKey(&Order{UserID: 1, OrderID: 2}))
+	sql, args = db.Build()
+	a.Equal(sql, "DELETE FROM orders WHERE user_id = ? AND order_id = ?")
+	a.Equal(args, []interface{}{int64(1), int64(2)})
+}
+
+func ExampleStruct_UpsertInto() {
+	type User struct {
+		ID     int64  `db:"id"`
+		Name   string `db:"name"`
+		Status int    `db:"status"`
+	}
+
+	var userStruct = NewStruct(new(User))
+	user := &User{
+		ID:     1234,
+		Name:   "Huan Du",
+		Status: 1,
+	}
+
+	sql, args := userStruct.For(PostgreSQL).UpsertInto("user", []string{"id"}, user).Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	sql, args = userStruct.UpsertInto("user", []string{"id"}, user).Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// INSERT INTO user (id, name, status) VALUES ($1, $2, $3) ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name, status = EXCLUDED.status
+	// [1234 Huan Du 1]
+	// INSERT INTO user (id, name, status) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE name = VALUES(name), status = VALUES(status)
+	// [1234 Huan Du 1]
+}
+
+func TestStructUpsertInto(t *testing.T) {
+	a := assert.New(t)
+	type Tag struct {
+		Name string `db:"name"`
+		Note string `db:"note" fieldtag:"extra"`
+	}
+	st := NewStruct(new(Tag)).For(SQLite)
+
+	sql, _ := st.WithoutTag("extra").UpsertInto("tags", []string{"name"}, Tag{Name: "go"}).Build()
+	a.Equal(sql, "INSERT INTO tags (name) VALUES (?) ON CONFLICT (name) DO NOTHING")
+
+	sql, _ = st.UpsertInto("tags", []string{"name"}, Tag{Name: "go"}).Build()
+	a.Equal(sql, "INSERT INTO tags (name, note) VALUES (?, ?) ON CONFLICT (name) DO UPDATE SET note = EXCLUDED.note")
+
+	sql, args := st.UpsertInto("tags", []string{"name"}, 123).Build()
+	a.Equal(sql, "INSERT INTO tags")
+	a.Equal(len(args), 0)
+}
+
+func ExampleStruct_SelectFromWithAlias() {
+	type User struct {
+		ID   int64  `db:"id"`
+		Name string `db:"name" fieldas:"user_name"`
+	}
+	type Order struct {
+		ID     int64 `db:"id"`
+		UserID int64 `db:"user_id"`
+	}
+
+	userStruct := NewStruct(new(User))
+	orderStruct := NewStruct(new(Order))
+
+	sb := userStruct.SelectFromWithAlias("users u", "u")
+	sb.Join("orders o", "o.user_id = u.id")
+	fmt.Println(sb)
+
+	sb = orderStruct.SelectFromWithAlias("orders o", "o")
+	fmt.Println(sb)
+
+	// Output:
+	// SELECT u.id AS u_id, u.name AS u_user_name FROM users u JOIN orders o ON o.user_id = u.id
+	// SELECT o.id AS o_id, o.user_id AS o_user_id FROM orders o
+}
+
+func TestStructSelectFromWithAlias(t *testing.T) {
+	a := assert.New(t)
+	type User struct {
+		ID int64 `db:"id" fieldopt:"withquote"`
+	}
+	st := NewStruct(new(User)).For(PostgreSQL)
+	a.Equal(st.SelectFromWithAlias("users", "u").String(), `SELECT users."id" AS "u_id" FROM users`)
+}
+
+func ExampleStruct_CreateTable() {
+	type User struct {
+		ID        int64     `db:"id" fieldopt:"pk,autoincrement"`
+		Name      string    `db:"name" fieldtype:"VARCHAR(64)" fieldopt:"notnull"`
+		Email     string    `db:"email" fieldopt:"notnull,unique"`
+		Bio       *string   `db:"bio"`
+		CreatedAt time.Time `db:"created_at" fieldopt:"notnull"`
+		Ignored   int       `db:"-"`
+	}
+
+	userStruct := NewStruct(new(User))
+	fmt.Println(userStruct.For(MySQL).CreateTable("users").IfNotExists())
+	fmt.Println(userStruct.For(PostgreSQL).CreateTable("users").IfNotExists())
+
+	// Output:
+	// CREATE TABLE IF NOT EXISTS users (id BIGINT AUTO_INCREMENT PRIMARY KEY, name VARCHAR(64) NOT NULL, email VARCHAR(255) NOT NULL UNIQUE, bio VARCHAR(255), created_at DATETIME NOT NULL)
+	// CREATE TABLE IF NOT EXISTS users (id BIGSERIAL PRIMARY KEY, name VARCHAR(64) NOT NULL, email VARCHAR(255) NOT NULL UNIQUE, bio VARCHAR(255), created_at TIMESTAMP NOT NULL)
+}
+
+func TestStructCreateTable(t *testing.T) {
+	a := assert.New(t)
+	type Item struct {
+		ID      int32           `db:"id" fieldtag:"pk"`
+		Flag    bool            `db:"flag" fieldopt:"withquote"`
+		Small   int8            `db:"small"`
+		Price   float64         `db:"price"`
+		Data    []byte          `db:"data"`
+		Note    sql.NullString  `db:"note"`
+		Count   sql.NullInt64   `db:"count" fieldtag:"stat"`
+		Unknown map[string]bool `db:"unknown"`
+	}
+	st := NewStruct(new(Item))
+
+	a.Equal(st.For(SQLite).CreateTable("items").String(),
+		`CREATE TABLE items (id INTEGER PRIMARY KEY, "flag" BOOLEAN, small SMALLINT, price REAL, data BLOB, note VARCHAR(255), count BIGINT, unknown)`)
+	a.Equal(st.For(PostgreSQL).WithTag("stat").CreateTable("items").String(),
+		"CREATE TABLE items (count BIGINT)")
+	a.Equal(st.For(PostgreSQL).WithoutTag("stat").CreateTable("items").String(),
+		`CREATE TABLE items (id INT PRIMARY KEY, "flag" BOOLEAN, small SMALLINT, price DOUBLE PRECISION, data BYTEA, note VARCHAR(255), unknown)`)
+}
@@ -31,6 +31,18 @@ type structField struct {
 	DBTag    string
 	Field    reflect.StructField
 
+	// JoinTable and JoinOn are set by the join option in `fieldopt` tag.
+	JoinTable string
+	JoinOn    string
+
+	// Type and constraints are set by `fieldtype` and `fieldopt` tags.
+	// They're used by `Struct#CreateTable`.
+	Type          string
+	NotNull       bool
+	PrimaryKey    bool
+	Unique        bool
+	AutoIncrement bool
+
 	omitEmptyTags omitEmptyTagMap
 }
 
@@ -104,7 +116,9 @@ func (sfs *structFields) parse(t reflect.Type, mapper FieldMapperFunc, prefix st
 		fieldopt := field.Tag.Get(FieldOpt)
 		opts := optRegex.FindAllString(fieldopt, -1)
 		isQuoted := false
+		notNull, primaryKey, unique, autoIncrement := false, false, false, false
 		omitEmptyTags := omitEmptyTagMap{}
+		var joinTable, joinOn string
 
 		for _, opt := range opts {
 			optMap := getOptMatchedMap(opt)
@@ -119,6 +133,21 @@ func (sfs *structFields) parse(t reflect.Type, mapper FieldMapperFunc, prefix st
 
 			case fieldOptWithQuote:
 				isQuoted = true
+
+			case fieldOptJoin:
+				joinTable, joinOn = parseJoinOptParams(optMap[optParams])
+
+			case fieldOptNotNull:
+				notNull = true
+
+			case fieldOptPrimaryKey:
+				primaryKey = true
+
+			case fieldOptUnique:
+				unique = true
+
+			case fieldOptAutoIncrement:
+				autoIncrement = true
 			}
 		}
 
@@ -129,6 +158,13 @@ func (sfs *structFields) parse(t reflect.Type, mapper FieldMapperFunc, prefix st
 		fieldtag := field.Tag.Get(FieldTag)
 		tags := splitTags(fieldtag)
 
+		for _, tag := range tags {
+			if tag == PrimaryKeyTag {
+				primaryKey = true
+				break
+			}
+		}
+
 		// Make struct field.
 		structField := &structField{
 			Name:          field.Name,
@@ -138,6 +174,13 @@ func (sfs *structFields) parse(t reflect.Type, mapper FieldMapperFunc, prefix st
 			IsQuoted:      isQuoted,
 			DBTag:         dbtag,
 			Field:         field,
+			JoinTable:     joinTable,
+			JoinOn:        joinOn,
+			Type:          field.Tag.Get(FieldType),
+			NotNull:       notNull,
+			PrimaryKey:    primaryKey,
+			Unique:        unique,
+			AutoIncrement: autoIncrement,
 			omitEmptyTags: omitEmptyTags,
 		}
 
@@ -278,6 +321,18 @@ func (sf *structField) NameForSelect(flavor Flavor) string {
 	return fmt.Sprintf("%s AS %s", sf.Quote(flavor), sf.As)
 }
 
+// aliasWithPrefix returns the key of sf prefixed with prefix and "_".
+// The result is quoted with flavor if sf is quoted.
+func (sf *structField) aliasWithPrefix(flavor Flavor, prefix string) string {
+	alias := prefix + "_" + sf.Key()
+
+	if !sf.IsQuoted {
+		return alias
+	}
+
+	return flavor.Quote(alias)
+}
+
 // Quote the Alias in sf with flavor.
 func (sf *structField) Quote(flavor Flavor) string {
 	if !sf.IsQuoted {
@@ -334,6 +389,17 @@ func getTagsFromOptParams(opts string) (tags []string) {
 	return
 }
 
+// parseJoinOptParams parses params of join option in the form of "table:onExpr".
+func parseJoinOptParams(params string) (table, on string) {
+	idx := strings.IndexRune(params, ':')
+
+	if idx == -1 {
+		return strings.TrimSpace(params), ""
+	}
+
+	return strings.TrimSpace(params[:idx]), strings.TrimSpace(params[idx+1:])
+}
+
 func splitTags(fieldtag string) (tags []string) {
 	parts := strings.Split(fieldtag, ",")
 
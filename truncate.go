@@ -0,0 +1,130 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+const (
+	truncateMarkerInit injectionMarker = iota
+	truncateMarkerAfterTruncate
+	truncateMarkerAfterOption
+)
+
+// NewTruncateBuilder creates a new TRUNCATE TABLE builder.
+func NewTruncateBuilder() *TruncateBuilder {
+	return DefaultFlavor.NewTruncateBuilder()
+}
+
+func newTruncateBuilder() *TruncateBuilder {
+	return &TruncateBuilder{
+		args:      &Args{},
+		injection: newInjection(),
+		marker:    truncateMarkerInit,
+	}
+}
+
+// TruncateBuilder is a builder to build TRUNCATE TABLE.
+//
+// SQLite doesn't support TRUNCATE TABLE. It's written as "DELETE FROM t" in SQLite instead.
+type TruncateBuilder struct {
+	tables          []string
+	restartIdentity bool
+	cascade         bool
+
+	args *Args
+
+	injection *injection
+	marker    injectionMarker
+}
+
+var _ Builder = new(TruncateBuilder)
+
+// TruncateTable sets table names in TRUNCATE TABLE.
+func TruncateTable(table ...string) *TruncateBuilder {
+	return DefaultFlavor.NewTruncateBuilder().TruncateTable(table...)
+}
+
+// TruncateTable sets table names in TRUNCATE TABLE.
+// Only PostgreSQL, DuckDB and ClickHouse support truncating more than one table in a statement.
+func (tb *TruncateBuilder) TruncateTable(table ...string) *TruncateBuilder {
+	tb.tables = EscapeAll(table...)
+	tb.marker = truncateMarkerAfterTruncate
+	return tb
+}
+
+// RestartIdentity adds RESTART IDENTITY to reset sequences owned by columns of the tables.
+//
+// It's only written in PostgreSQL and omitted in other flavors.
+func (tb *TruncateBuilder) RestartIdentity() *TruncateBuilder {
+	tb.restartIdentity = true
+	tb.marker = truncateMarkerAfterOption
+	return tb
+}
+
+// Cascade adds CASCADE to truncate all tables referencing the tables by foreign keys.
+//
+// It's only written in PostgreSQL and Oracle and omitted in other flavors.
+func (tb *TruncateBuilder) Cascade() *TruncateBuilder {
+	tb.cascade = true
+	tb.marker = truncateMarkerAfterOption
+	return tb
+}
+
+// String returns the compiled TRUNCATE TABLE string.
+func (tb *TruncateBuilder) String() string {
+	s, _ := tb.Build()
+	return s
+}
+
+// Build returns compiled TRUNCATE TABLE string and args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (tb *TruncateBuilder) Build() (sql string, args []interface{}) {
+	return tb.BuildWithFlavor(tb.args.Flavor)
+}
+
+// BuildWithFlavor returns compiled TRUNCATE TABLE string and args with flavor and initial args.
+// They can be used in `DB#Query` of package `database/sql` directly.
+func (tb *TruncateBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	buf := newStringBuilder()
+	tb.injection.WriteTo(buf, truncateMarkerInit)
+
+	if len(tb.tables) > 0 {
+		if flavor == SQLite {
+			buf.WriteLeadingString("DELETE FROM ")
+		} else {
+			buf.WriteLeadingString("TRUNCATE TABLE ")
+		}
+
+		buf.WriteStrings(tb.tables, ", ")
+	}
+
+	tb.injection.WriteTo(buf, truncateMarkerAfterTruncate)
+
+	if tb.restartIdentity && flavor == PostgreSQL {
+		buf.WriteLeadingString("RESTART IDENTITY")
+	}
+
+	if tb.cascade && (flavor == PostgreSQL || flavor == Oracle) {
+		buf.WriteLeadingString("CASCADE")
+	}
+
+	tb.injection.WriteTo(buf, truncateMarkerAfterOption)
+	return tb.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+}
+
+// SetFlavor sets the flavor of compiled sql.
+func (tb *TruncateBuilder) SetFlavor(flavor Flavor) (old Flavor) {
+	old = tb.args.Flavor
+	tb.args.Flavor = flavor
+	return
+}
+
+// Flavor returns flavor of builder
+func (tb *TruncateBuilder) Flavor() Flavor {
+	return tb.args.Flavor
+}
+
+// SQL adds an arbitrary sql to current position.
+func (tb *TruncateBuilder) SQL(sql string) *TruncateBuilder {
+	tb.injection.SQL(tb.marker, sql)
+	return tb
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleTruncateTable() {
+	sql := TruncateTable("demo.user").String()
+	fmt.Println(sql)
+
+	// Output:
+	// TRUNCATE TABLE demo.user
+}
+
+func ExampleTruncateBuilder() {
+	tb := PostgreSQL.NewTruncateBuilder()
+	tb.TruncateTable("orders", "order_items").RestartIdentity().Cascade()
+
+	fmt.Println(tb)
+
+	// Output:
+	// TRUNCATE TABLE orders, order_items RESTART IDENTITY CASCADE
+}
+
+func ExampleTruncateBuilder_SQL() {
+	tb := NewTruncateBuilder()
+	tb.SQL("/* before */")
+	tb.TruncateTable("demo.user")
+	tb.SQL("/* after truncate */")
+	tb.Cascade()
+	tb.SQL("/* after option */")
+
+	fmt.Println(tb)
+
+	// Output:
+	// /* before */ TRUNCATE TABLE demo.user /* after truncate */ /* after option */
+}
+
+func TestTruncateBuilderFlavors(t *testing.T) {
+	a := assert.New(t)
+	tb := TruncateTable("t").RestartIdentity().Cascade()
+
+	cases := map[Flavor]string{
+		MySQL:      "TRUNCATE TABLE t",
+		PostgreSQL: "TRUNCATE TABLE t RESTART IDENTITY CASCADE",
+		Oracle:     "TRUNCATE TABLE t CASCADE",
+		SQLite:     "DELETE FROM t",
+		SQLServer:  "TRUNCATE TABLE t",
+	}
+
+	for flavor, expected := range cases {
+		sql, args := tb.BuildWithFlavor(flavor)
+		a.Equal(sql, expected)
+		a.Equal(len(args), 0)
+	}
+}
+
+func TestTruncateBuilderGetFlavor(t *testing.T) {
+	a := assert.New(t)
+	tb := newTruncateBuilder()
+
+	tb.SetFlavor(PostgreSQL)
+	a.Equal(PostgreSQL, tb.Flavor())
+
+	tbClick := ClickHouse.NewTruncateBuilder()
+	a.Equal(ClickHouse, tbClick.Flavor())
+}
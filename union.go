@@ -8,8 +8,12 @@ import (
 )
 
 const (
-	unionDistinct = " UNION " // Default union type is DISTINCT.
-	unionAll      = " UNION ALL "
+	unionDistinct     = " UNION " // Default union type is DISTINCT.
+	unionAll          = " UNION ALL "
+	intersectDistinct = " INTERSECT "
+	intersectAll      = " INTERSECT ALL "
+	exceptDistinct    = " EXCEPT "
+	exceptAll         = " EXCEPT ALL "
 )
 
 const (
@@ -35,9 +39,13 @@ func newUnionBuilder() *UnionBuilder {
 }
 
 // UnionBuilder is a builder to build UNION.
+// It can also build INTERSECT and EXCEPT and mix these set operators in one statement,
+// e.g. `Union(a, b).Except(c).UnionAll(d)`.
+// Every call of `Union`, `UnionAll`, `Intersect`, `IntersectAll`, `Except` or `ExceptAll`
+// appends builders to the statement with the operator.
 type UnionBuilder struct {
 	opt         string
-	builderVars []string
+	operands    []unionOperand
 	orderByCols []string
 	order       string
 	limit       int
@@ -51,6 +59,13 @@ type UnionBuilder struct {
 
 var _ Builder = new(UnionBuilder)
 
+// unionOperand is a builder in the set operation and the operator before it.
+// The operator of the first operand is ignored.
+type unionOperand struct {
+	opt        string
+	builderVar string
+}
+
 // Union unions all builders together using UNION operator.
 func Union(builders ...Builder) *UnionBuilder {
 	return DefaultFlavor.NewUnionBuilder().Union(builders...)
@@ -71,19 +86,82 @@ func (ub *UnionBuilder) UnionAll(builders ...Builder) *UnionBuilder {
 	return ub.union(unionAll, builders...)
 }
 
-func (ub *UnionBuilder) union(opt string, builders ...Builder) *UnionBuilder {
-	builderVars := make([]string, 0, len(builders))
+// Intersect intersects all builders together using INTERSECT operator.
+func Intersect(builders ...Builder) *UnionBuilder {
+	return DefaultFlavor.NewUnionBuilder().Intersect(builders...)
+}
+
+// Intersect intersects all builders together using INTERSECT operator.
+//
+// INTERSECT is supported by MySQL since 8.0.31.
+// The SQL is written as it is in all flavors.
+func (ub *UnionBuilder) Intersect(builders ...Builder) *UnionBuilder {
+	return ub.union(intersectDistinct, builders...)
+}
+
+// IntersectAll intersects all builders together using INTERSECT ALL operator.
+func IntersectAll(builders ...Builder) *UnionBuilder {
+	return DefaultFlavor.NewUnionBuilder().IntersectAll(builders...)
+}
+
+// IntersectAll intersects all builders together using INTERSECT ALL operator.
+//
+// INTERSECT ALL is supported by MySQL since 8.0.31 and not supported by SQLite and SQLServer.
+// The SQL is written as it is in all flavors.
+func (ub *UnionBuilder) IntersectAll(builders ...Builder) *UnionBuilder {
+	return ub.union(intersectAll, builders...)
+}
+
+// Except returns rows in the first builder but not in the rest using EXCEPT operator.
+func Except(builders ...Builder) *UnionBuilder {
+	return DefaultFlavor.NewUnionBuilder().Except(builders...)
+}
 
+// Except returns rows in the first builder but not in the rest using EXCEPT operator.
+//
+// EXCEPT is supported by MySQL since 8.0.31.
+// The SQL is written as it is in all flavors.
+func (ub *UnionBuilder) Except(builders ...Builder) *UnionBuilder {
+	return ub.union(exceptDistinct, builders...)
+}
+
+// ExceptAll returns rows in the first builder but not in the rest using EXCEPT ALL operator.
+func ExceptAll(builders ...Builder) *UnionBuilder {
+	return DefaultFlavor.NewUnionBuilder().ExceptAll(builders...)
+}
+
+// ExceptAll returns rows in the first builder but not in the rest using EXCEPT ALL operator.
+//
+// EXCEPT ALL is supported by MySQL since 8.0.31 and not supported by SQLite and SQLServer.
+// The SQL is written as it is in all flavors.
+func (ub *UnionBuilder) ExceptAll(builders ...Builder) *UnionBuilder {
+	return ub.union(exceptAll, builders...)
+}
+
+func (ub *UnionBuilder) union(opt string, builders ...Builder) *UnionBuilder {
 	for _, b := range builders {
-		builderVars = append(builderVars, ub.Var(b))
+		ub.operands = append(ub.operands, unionOperand{
+			opt:        opt,
+			builderVar: ub.Var(b),
+		})
 	}
 
 	ub.opt = opt
-	ub.builderVars = builderVars
 	ub.marker = unionMarkerAfterUnion
 	return ub
 }
 
+// Add appends more builders with the operator set by the last call of `Union`, `UnionAll`,
+// `Intersect`, `IntersectAll`, `Except` or `ExceptAll`.
+// If none of them is called, UNION is used.
+func (ub *UnionBuilder) Add(builders ...Builder) *UnionBuilder {
+	if ub.opt == "" {
+		ub.opt = unionDistinct
+	}
+
+	return ub.union(ub.opt, builders...)
+}
+
 // OrderBy sets columns of ORDER BY in SELECT.
 func (ub *UnionBuilder) OrderBy(col ...string) *UnionBuilder {
 	ub.orderByCols = col
@@ -119,6 +197,18 @@ func (ub *UnionBuilder) Offset(offset int) *UnionBuilder {
 	return ub
 }
 
+// Clone returns a deep copy of ub.
+// It's useful to build variants of a union without changing the original one.
+// Builders in the union are not copied, so changing them affects both ub and the clone.
+func (ub *UnionBuilder) Clone() *UnionBuilder {
+	cloned := *ub
+	cloned.operands = append([]unionOperand(nil), ub.operands...)
+	cloned.orderByCols = append([]string(nil), ub.orderByCols...)
+	cloned.args = ub.args.clone()
+	cloned.injection = ub.injection.clone()
+	return &cloned
+}
+
 // String returns the compiled SELECT string.
 func (ub *UnionBuilder) String() string {
 	s, _ := ub.Build()
@@ -137,30 +227,8 @@ func (ub *UnionBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}
 	buf := newStringBuilder()
 	ub.injection.WriteTo(buf, unionMarkerInit)
 
-	if len(ub.builderVars) > 0 {
-		needParen := flavor != SQLite
-
-		if needParen {
-			buf.WriteLeadingString("(")
-			buf.WriteString(ub.builderVars[0])
-			buf.WriteRune(')')
-		} else {
-			buf.WriteLeadingString(ub.builderVars[0])
-		}
-
-		for _, b := range ub.builderVars[1:] {
-			buf.WriteString(ub.opt)
-
-			if needParen {
-				buf.WriteRune('(')
-			}
-
-			buf.WriteString(b)
-
-			if needParen {
-				buf.WriteRune(')')
-			}
-		}
+	if len(ub.operands) > 0 {
+		buf.WriteLeadingString(ub.buildOperands(flavor != SQLite))
 	}
 
 	ub.injection.WriteTo(buf, unionMarkerAfterUnion)
@@ -183,7 +251,7 @@ func (ub *UnionBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}
 
 	}
 
-	if ((MySQL == flavor || Informix == flavor) && ub.limit >= 0) || PostgreSQL == flavor {
+	if ((flavor.isMySQLCompatible() || Informix == flavor) && ub.limit >= 0) || PostgreSQL == flavor || ANSI == flavor || DuckDB == flavor {
 		if ub.offset >= 0 {
 			buf.WriteLeadingString("OFFSET ")
 			buf.WriteString(strconv.Itoa(ub.offset))
@@ -197,6 +265,39 @@ func (ub *UnionBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}
 	return ub.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
 }
 
+// buildOperands joins all operands with their operators.
+//
+// Set operators are applied from left to right in the order they are added.
+// As INTERSECT takes precedence over UNION and EXCEPT in SQL,
+// all preceding operands are wrapped with parentheses before INTERSECT
+// if there is any UNION or EXCEPT among them and needParen is true.
+func (ub *UnionBuilder) buildOperands(needParen bool) string {
+	wrap := func(s string) string {
+		if needParen {
+			return "(" + s + ")"
+		}
+
+		return s
+	}
+	expr := wrap(ub.operands[0].builderVar)
+	mixed := false
+
+	for _, operand := range ub.operands[1:] {
+		isIntersect := operand.opt == intersectDistinct || operand.opt == intersectAll
+
+		if isIntersect && mixed {
+			expr = wrap(expr)
+			mixed = false
+		} else if !isIntersect {
+			mixed = true
+		}
+
+		expr += operand.opt + wrap(operand.builderVar)
+	}
+
+	return expr
+}
+
 // SetFlavor sets the flavor of compiled sql.
 func (ub *UnionBuilder) SetFlavor(flavor Flavor) (old Flavor) {
 	old = ub.args.Flavor
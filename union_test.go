@@ -104,3 +104,115 @@ func TestUnionBuilderGetFlavor(t *testing.T) {
 	flavor = ubClick.Flavor()
 	a.Equal(ClickHouse, flavor)
 }
+
+func ExampleSelectBuilder_UnionWith() {
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("id").From("user").Where(sb.GreaterThan("level", 10))
+
+	other := Select("id").From("admin")
+	other.Where(other.Equal("status", 1))
+
+	ub := sb.UnionAllWith(other)
+	ub.Add(Build("SELECT id FROM guest WHERE name = $?", "root"))
+	ub.OrderBy("id")
+
+	sql, args := ub.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// (SELECT id FROM user WHERE level > $1) UNION ALL (SELECT id FROM admin WHERE status = $2) UNION ALL (SELECT id FROM guest WHERE name = $3) ORDER BY id
+	// [10 1 root]
+}
+
+func TestSelectBuilderUnionWith(t *testing.T) {
+	a := assert.New(t)
+	sb := SQLServer.NewSelectBuilder()
+	sb.Select("a").From("t1")
+
+	ub := sb.UnionWith(Select("b").From("t2"))
+	a.Equal(ub.Flavor(), SQLServer)
+	a.Equal(ub.String(), "(SELECT a FROM t1) UNION (SELECT b FROM t2)")
+
+	ub = NewUnionBuilder().Add(Select("a").From("t1"), Select("b").From("t2"))
+	a.Equal(ub.String(), "(SELECT a FROM t1) UNION (SELECT b FROM t2)")
+}
+
+func ExampleIntersect() {
+	sb1 := PostgreSQL.NewSelectBuilder()
+	sb1.Select("user_id").From("orders").Where(sb1.GreaterThan("amount", 100))
+
+	sb2 := PostgreSQL.NewSelectBuilder()
+	sb2.Select("user_id").From("subscriptions").Where(sb2.Equal("status", "active"))
+
+	ub := PostgreSQL.NewUnionBuilder().Intersect(sb1, sb2)
+	ub.OrderBy("user_id").Limit(10)
+
+	sql, args := ub.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// (SELECT user_id FROM orders WHERE amount > $1) INTERSECT (SELECT user_id FROM subscriptions WHERE status = $2) ORDER BY user_id LIMIT 10
+	// [100 active]
+}
+
+func TestUnionBuilderSetOperators(t *testing.T) {
+	a := assert.New(t)
+	sb1 := Select("id").From("t1")
+	sb2 := Select("id").From("t2")
+
+	a.Equal(IntersectAll(sb1, sb2).String(), "(SELECT id FROM t1) INTERSECT ALL (SELECT id FROM t2)")
+	a.Equal(Except(sb1, sb2).String(), "(SELECT id FROM t1) EXCEPT (SELECT id FROM t2)")
+	a.Equal(ExceptAll(sb1, sb2).Add(Select("id").From("t3")).String(), "(SELECT id FROM t1) EXCEPT ALL (SELECT id FROM t2) EXCEPT ALL (SELECT id FROM t3)")
+
+	sql, _ := Except(sb1, sb2).BuildWithFlavor(SQLite)
+	a.Equal(sql, "SELECT id FROM t1 EXCEPT SELECT id FROM t2")
+}
+
+func ExampleUnionBuilder_Except() {
+	sb1 := Select("id").From("users")
+	sb2 := Select("id").From("admins")
+	sb3 := Select("user_id").From("banned_users")
+	sb4 := Select("id").From("guests")
+
+	ub := Union(sb1, sb2).Except(sb3).UnionAll(sb4)
+	fmt.Println(ub)
+
+	// Output:
+	// (SELECT id FROM users) UNION (SELECT id FROM admins) EXCEPT (SELECT user_id FROM banned_users) UNION ALL (SELECT id FROM guests)
+}
+
+func TestUnionBuilderMixedOperators(t *testing.T) {
+	a := assert.New(t)
+	sb1 := Select("id").From("t1")
+	sb2 := Select("id").From("t2")
+	sb3 := Select("id").From("t3")
+	sb4 := Select("id").From("t4")
+
+	ub := Union(sb1, sb2).Intersect(sb3).Add(sb4)
+	a.Equal(ub.String(), "((SELECT id FROM t1) UNION (SELECT id FROM t2)) INTERSECT (SELECT id FROM t3) INTERSECT (SELECT id FROM t4)")
+
+	sql, _ := ub.BuildWithFlavor(SQLite)
+	a.Equal(sql, "SELECT id FROM t1 UNION SELECT id FROM t2 INTERSECT SELECT id FROM t3 INTERSECT SELECT id FROM t4")
+
+	ub = Intersect(sb1, sb2).Union(sb3).Intersect(sb4)
+	a.Equal(ub.String(), "((SELECT id FROM t1) INTERSECT (SELECT id FROM t2) UNION (SELECT id FROM t3)) INTERSECT (SELECT id FROM t4)")
+
+	ub = NewUnionBuilder().Union(sb1)
+	a.Equal(ub.String(), "(SELECT id FROM t1)")
+}
+
+func TestUnionBuilderClone(t *testing.T) {
+	a := assert.New(t)
+	ub := Union(Select("id").From("t1"), Select("id").From("t2")).OrderBy("id")
+	ub.SQL("/* order */")
+
+	cloned := ub.Clone()
+	cloned.Except(Select("id").From("t3")).Limit(10)
+	cloned.SQL("/* limit */")
+	cloned.OrderBy("id", "name")
+
+	a.Equal(ub.String(), "(SELECT id FROM t1) UNION (SELECT id FROM t2) ORDER BY id /* order */")
+	a.Equal(cloned.String(), "(SELECT id FROM t1) UNION (SELECT id FROM t2) EXCEPT (SELECT id FROM t3) ORDER BY id, name /* order */ LIMIT 10 /* limit */")
+}
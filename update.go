@@ -13,9 +13,11 @@ const (
 	updateMarkerAfterWith
 	updateMarkerAfterUpdate
 	updateMarkerAfterSet
+	updateMarkerAfterFrom
 	updateMarkerAfterWhere
 	updateMarkerAfterOrderBy
 	updateMarkerAfterLimit
+	updateMarkerAfterReturning
 )
 
 // NewUpdateBuilder creates a new UPDATE builder.
@@ -52,9 +54,14 @@ type UpdateBuilder struct {
 
 	tables      []string
 	assignments []string
+	fromTables  []string
+	joinOptions []JoinOption
+	joinTables  []string
+	joinExprs   [][]string
 	orderByCols []string
 	order       string
 	limit       int
+	returning   []string
 
 	args *Args
 
@@ -112,6 +119,12 @@ func (ub *UpdateBuilder) Set(assignment ...string) *UpdateBuilder {
 	return ub
 }
 
+// SetExpr sets the assignments in SET like `Set`.
+// A string in assignment is used as it is and an `Expression` is written with its args bound.
+func (ub *UpdateBuilder) SetExpr(assignment ...interface{}) *UpdateBuilder {
+	return ub.Set(exprStrings(ub.args, assignment)...)
+}
+
 // SetMore appends the assignments in SET.
 func (ub *UpdateBuilder) SetMore(assignment ...string) *UpdateBuilder {
 	ub.assignments = append(ub.assignments, assignment...)
@@ -119,6 +132,49 @@ func (ub *UpdateBuilder) SetMore(assignment ...string) *UpdateBuilder {
 	return ub
 }
 
+// From sets tables in FROM to update rows with values in other tables.
+//
+// In MySQL, tables are written after tables in UPDATE, e.g. "UPDATE a, b SET ...".
+// In other flavors, tables are written after SET, e.g. "UPDATE a SET ... FROM b".
+//
+// It's supported by MySQL, MariaDB, PostgreSQL, SQLite, SQLServer and DuckDB.
+// The FROM is written as it is in other flavors and `BuildWithFlavorStrict` reports it
+// as an error wrapping ErrUnsupportedFlavor.
+func (ub *UpdateBuilder) From(table ...string) *UpdateBuilder {
+	ub.fromTables = table
+	ub.marker = updateMarkerAfterFrom
+	return ub
+}
+
+// Join sets expressions of JOIN in UPDATE.
+//
+// It builds a JOIN expression like
+//
+//	JOIN table ON onExpr[0] AND onExpr[1] ...
+//
+// See doc in `JoinWithOption` for details.
+func (ub *UpdateBuilder) Join(table string, onExpr ...string) *UpdateBuilder {
+	return ub.JoinWithOption("", table, onExpr...)
+}
+
+// JoinWithOption sets expressions of JOIN with an option in UPDATE.
+//
+// In MySQL, JOIN is written between tables in UPDATE and SET,
+// e.g. "UPDATE a JOIN b ON a.id = b.id SET ...".
+// In other flavors, JOIN is written after tables in FROM,
+// e.g. "UPDATE a SET ... FROM b JOIN c ON b.id = c.id".
+// As the table in UPDATE cannot be joined in FROM in these flavors,
+// `From` must be called to set the first table to join with.
+// If no table is set in `From` or `With`, JOIN is not written in these flavors
+// and `BuildWithFlavorStrict` reports an error wrapping ErrUnsupportedFlavor.
+func (ub *UpdateBuilder) JoinWithOption(option JoinOption, table string, onExpr ...string) *UpdateBuilder {
+	ub.joinOptions = append(ub.joinOptions, option)
+	ub.joinTables = append(ub.joinTables, table)
+	ub.joinExprs = append(ub.joinExprs, onExpr)
+	ub.marker = updateMarkerAfterFrom
+	return ub
+}
+
 // Where sets expressions of WHERE in UPDATE.
 func (ub *UpdateBuilder) Where(andExpr ...string) *UpdateBuilder {
 	if len(andExpr) == 0 || estimateStringsBytes(andExpr) == 0 {
@@ -134,6 +190,12 @@ func (ub *UpdateBuilder) Where(andExpr ...string) *UpdateBuilder {
 	return ub
 }
 
+// WhereExpr sets expressions of WHERE in UPDATE like `Where`.
+// A string in andExpr is used as it is and an `Expression` is written with its args bound.
+func (ub *UpdateBuilder) WhereExpr(andExpr ...interface{}) *UpdateBuilder {
+	return ub.Where(exprStrings(ub.args, andExpr)...)
+}
+
 // AddWhereClause adds all clauses in the whereClause to SELECT.
 func (ub *UpdateBuilder) AddWhereClause(whereClause *WhereClause) *UpdateBuilder {
 	if ub.WhereClause == nil {
@@ -192,6 +254,12 @@ func (ub *UpdateBuilder) OrderBy(col ...string) *UpdateBuilder {
 	return ub
 }
 
+// OrderByExpr sets columns of ORDER BY in UPDATE like `OrderBy`.
+// A string in col is used as it is and an `Expression` is written with its args bound.
+func (ub *UpdateBuilder) OrderByExpr(col ...interface{}) *UpdateBuilder {
+	return ub.OrderBy(exprStrings(ub.args, col)...)
+}
+
 // Asc sets order of ORDER BY to ASC.
 func (ub *UpdateBuilder) Asc() *UpdateBuilder {
 	ub.order = "ASC"
@@ -213,6 +281,25 @@ func (ub *UpdateBuilder) Limit(limit int) *UpdateBuilder {
 	return ub
 }
 
+// Returning sets columns returned by UPDATE.
+// Use "*" to return all columns, e.g. `Returning("*")`.
+//
+// It's written as "RETURNING col..." at the end of UPDATE in PostgreSQL, SQLite and DuckDB.
+// It's ignored in other flavors.
+func (ub *UpdateBuilder) Returning(col ...string) *UpdateBuilder {
+	ub.returning = col
+	ub.marker = updateMarkerAfterReturning
+	return ub
+}
+
+// ReturningAs adds a column returned by UPDATE with an alias, e.g. "RETURNING col AS alias".
+// Unlike `Returning`, it appends the column to existing returning columns.
+func (ub *UpdateBuilder) ReturningAs(col, alias string) *UpdateBuilder {
+	ub.returning = append(ub.returning, col+" AS "+alias)
+	ub.marker = updateMarkerAfterReturning
+	return ub
+}
+
 // NumAssignment returns the number of assignments to update.
 func (ub *UpdateBuilder) NumAssignment() int {
 	return len(ub.assignments)
@@ -230,6 +317,59 @@ func (ub *UpdateBuilder) Build() (sql string, args []interface{}) {
 	return ub.BuildWithFlavor(ub.args.Flavor)
 }
 
+// BuildE returns compiled UPDATE string and args like `Build`.
+// Unlike `Build`, it returns an error if the UPDATE is invalid, e.g. an expression built by
+// a Cond not sharing args with the builder is used. See `BuildWithFlavorStrict` for details.
+//
+// The error names the offending expression and its clause if it's found in the builder.
+func (ub *UpdateBuilder) BuildE() (sql string, args []interface{}, err error) {
+	return ub.BuildWithFlavorStrict(ub.args.Flavor)
+}
+
+// BuildWithFlavorStrict works like `BuildWithFlavor` and validates the compiled UPDATE.
+// It returns an error wrapping ErrInvalidArg if any placeholder cannot be resolved,
+// or an error wrapping ErrUnsupportedFlavor if any clause would be omitted in flavor.
+func (ub *UpdateBuilder) BuildWithFlavorStrict(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}, err error) {
+	return buildStrict(ub, flavor, initialArg...)
+}
+
+func (ub *UpdateBuilder) checkFlavor(flavor Flavor) error {
+	if len(ub.returning) > 0 {
+		switch flavor {
+		case PostgreSQL, SQLite, DuckDB:
+		default:
+			return unsupportedFlavorError("RETURNING", flavor)
+		}
+	}
+
+	if len(ub.fromTables) > 0 || len(ub.joinTables) > 0 {
+		switch flavor {
+		case MySQL, MariaDB, PostgreSQL, SQLite, SQLServer, DuckDB:
+		default:
+			return unsupportedFlavorError("UPDATE with FROM or JOIN", flavor)
+		}
+	}
+
+	if len(ub.joinTables) > 0 && !flavor.isMySQLCompatible() && len(ub.fromTables) == 0 &&
+		(ub.cteBuilder == nil || len(ub.cteBuilder.tableNamesForFrom()) == 0) {
+		return unsupportedFlavorError("JOIN without FROM", flavor)
+	}
+
+	return nil
+}
+
+func (ub *UpdateBuilder) listExprs() []exprGroup {
+	groups := []exprGroup{
+		{clause: "SET", args: ub.args, exprs: ub.assignments},
+	}
+
+	if ub.WhereClause != nil {
+		groups = append(groups, clauseExprGroups("WHERE", ub.WhereClause.clauses)...)
+	}
+
+	return groups
+}
+
 // BuildWithFlavor returns compiled UPDATE string and args with flavor and initial args.
 // They can be used in `DB#Query` of package `database/sql` directly.
 func (ub *UpdateBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
@@ -242,13 +382,18 @@ func (ub *UpdateBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 	}
 
 	switch flavor {
-	case MySQL:
+	case MySQL, MariaDB:
 		// CTE table names should be written after UPDATE keyword in MySQL.
 		tableNames := ub.TableNames()
 
 		if len(tableNames) > 0 {
 			buf.WriteLeadingString("UPDATE ")
 			buf.WriteStrings(tableNames, ", ")
+
+			if len(ub.fromTables) > 0 {
+				buf.WriteString(", ")
+				buf.WriteStrings(ub.fromTables, ", ")
+			}
 		}
 
 	default:
@@ -260,6 +405,14 @@ func (ub *UpdateBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 
 	ub.injection.WriteTo(buf, updateMarkerAfterUpdate)
 
+	if flavor.isMySQLCompatible() {
+		ub.writeJoins(buf)
+
+		if len(ub.fromTables) > 0 || len(ub.joinTables) > 0 {
+			ub.injection.WriteTo(buf, updateMarkerAfterFrom)
+		}
+	}
+
 	if len(ub.assignments) > 0 {
 		buf.WriteLeadingString("SET ")
 		buf.WriteStrings(ub.assignments, ", ")
@@ -267,15 +420,19 @@ func (ub *UpdateBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 
 	ub.injection.WriteTo(buf, updateMarkerAfterSet)
 
-	if flavor != MySQL {
+	if !flavor.isMySQLCompatible() {
 		// For ISO SQL, CTE table names should be written after FROM keyword.
+		fromTables := ub.fromTables
+
 		if ub.cteBuilder != nil {
-			cteTableNames := ub.cteBuilder.tableNamesForFrom()
+			fromTables = append(fromTables[:len(fromTables):len(fromTables)], ub.cteBuilder.tableNamesForFrom()...)
+		}
 
-			if len(cteTableNames) > 0 {
-				buf.WriteLeadingString("FROM ")
-				buf.WriteStrings(cteTableNames, ", ")
-			}
+		if len(fromTables) > 0 {
+			buf.WriteLeadingString("FROM ")
+			buf.WriteStrings(fromTables, ", ")
+			ub.writeJoins(buf)
+			ub.injection.WriteTo(buf, updateMarkerAfterFrom)
 		}
 	}
 
@@ -307,9 +464,33 @@ func (ub *UpdateBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{
 		ub.injection.WriteTo(buf, updateMarkerAfterLimit)
 	}
 
+	if len(ub.returning) > 0 && (flavor == PostgreSQL || flavor == SQLite || flavor == DuckDB) {
+		buf.WriteLeadingString("RETURNING ")
+		buf.WriteStrings(ub.returning, ", ")
+		ub.injection.WriteTo(buf, updateMarkerAfterReturning)
+	}
+
 	return ub.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
 }
 
+func (ub *UpdateBuilder) writeJoins(buf *stringBuilder) {
+	for i := range ub.joinTables {
+		if option := ub.joinOptions[i]; option != "" {
+			buf.WriteLeadingString(string(option))
+			buf.WriteString(" JOIN ")
+		} else {
+			buf.WriteLeadingString("JOIN ")
+		}
+
+		buf.WriteString(ub.joinTables[i])
+
+		if exprs := ub.joinExprs[i]; len(exprs) > 0 && ub.joinOptions[i].hasJoinCondition() {
+			buf.WriteString(" ON ")
+			buf.WriteStrings(exprs, " AND ")
+		}
+	}
+}
+
 // SetFlavor sets the flavor of compiled sql.
 func (ub *UpdateBuilder) SetFlavor(flavor Flavor) (old Flavor) {
 	old = ub.args.Flavor
@@ -327,3 +508,9 @@ func (ub *UpdateBuilder) SQL(sql string) *UpdateBuilder {
 	ub.injection.SQL(ub.marker, sql)
 	return ub
 }
+
+// SQLf adds an arbitrary sql built by `Build` with format and args to current position.
+// It's a shortcut of `ub.SQL(ub.Var(Build(format, args...)))`.
+func (ub *UpdateBuilder) SQLf(format string, args ...interface{}) *UpdateBuilder {
+	return ub.SQL(ub.Var(Build(format, args...)))
+}
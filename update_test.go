@@ -162,3 +162,86 @@ func TestUpdateBuilderGetFlavor(t *testing.T) {
 	flavor = ubClick.Flavor()
 	a.Equal(ClickHouse, flavor)
 }
+
+func ExampleUpdateBuilder_Join() {
+	ub := NewUpdateBuilder()
+	ub.Update("orders o")
+	ub.Join("users u", "u.id = o.user_id")
+	ub.Set(ub.Assign("o.status", "vip"))
+	ub.Where(ub.GreaterThan("u.level", 10))
+
+	fmt.Println(ub.BuildWithFlavor(MySQL))
+
+	// Output:
+	// UPDATE orders o JOIN users u ON u.id = o.user_id SET o.status = ? WHERE u.level > ? [vip 10]
+}
+
+func ExampleUpdateBuilder_From() {
+	ub := NewUpdateBuilder()
+	ub.Update("orders")
+	ub.From("users u")
+	ub.JoinWithOption(LeftJoin, "levels l", "l.id = u.level_id")
+	ub.Set("status = l.name")
+	ub.Where("u.id = orders.user_id")
+
+	fmt.Println(ub.BuildWithFlavor(PostgreSQL))
+	fmt.Println(ub.BuildWithFlavor(MySQL))
+
+	// Output:
+	// UPDATE orders SET status = l.name FROM users u LEFT JOIN levels l ON l.id = u.level_id WHERE u.id = orders.user_id []
+	// UPDATE orders, users u LEFT JOIN levels l ON l.id = u.level_id SET status = l.name WHERE u.id = orders.user_id []
+}
+
+func TestUpdateBuilderFromWithCTE(t *testing.T) {
+	a := assert.New(t)
+	ub := With(
+		CTETable("users").As(Select("id").From("members")),
+	).Update("orders").Set("status = 1")
+	ub.From("levels").SQL("/* after from */")
+	ub.Where("orders.user_id = users.id", "levels.id = orders.level_id")
+
+	sql, _ := ub.BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "WITH users AS (SELECT id FROM members) UPDATE orders SET status = 1 FROM levels, users /* after from */ WHERE orders.user_id = users.id AND levels.id = orders.level_id")
+
+	ub = Update("orders").Join("users", "users.id = orders.user_id").Set("status = 1")
+	sql, _ = ub.BuildWithFlavor(PostgreSQL)
+	a.Equal(sql, "UPDATE orders SET status = 1")
+}
+
+func TestUpdateBuilderSQLf(t *testing.T) {
+	a := assert.New(t)
+	ub := NewUpdateBuilder()
+	ub.Update("t").Set(ub.Assign("a", 1))
+	ub.SQLf("/* $? */", "x")
+
+	sql, args := ub.Build()
+	a.Equal(sql, "UPDATE t SET a = ? /* ? */")
+	a.Equal(args, []interface{}{1, "x"})
+}
+
+func ExampleUpdateBuilder_Returning() {
+	ub := PostgreSQL.NewUpdateBuilder()
+	ub.Update("users")
+	ub.Set(ub.Incr("visits"))
+	ub.Where(ub.Equal("id", 1234))
+	ub.Returning("id").ReturningAs("visits", "new_visits")
+
+	sql, args := ub.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// UPDATE users SET visits = visits + 1 WHERE id = $1 RETURNING id, visits AS new_visits
+	// [1234]
+}
+
+func TestUpdateBuilderReturning(t *testing.T) {
+	a := assert.New(t)
+	ub := Update("t").Set("a = 1").Returning("*")
+	ub.SQL("/* after returning */")
+
+	a.Equal(ub.String(), "UPDATE t SET a = 1")
+
+	sql, _ := ub.BuildWithFlavor(SQLite)
+	a.Equal(sql, "UPDATE t SET a = 1 RETURNING * /* after returning */")
+}
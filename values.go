@@ -0,0 +1,179 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+const (
+	valuesTableMarkerInit injectionMarker = iota
+	valuesTableMarkerAfterValues
+	valuesTableMarkerAfterAs
+)
+
+// NewValuesTableBuilder creates a new VALUES table builder.
+func NewValuesTableBuilder() *ValuesTableBuilder {
+	return DefaultFlavor.NewValuesTableBuilder()
+}
+
+func newValuesTableBuilder() *ValuesTableBuilder {
+	return &ValuesTableBuilder{
+		args:      &Args{},
+		injection: newInjection(),
+	}
+}
+
+// ValuesTableBuilder is a builder to build a VALUES list as a table source,
+// e.g. "(VALUES (1, 'a'), (2, 'b')) AS t (id, name)".
+//
+// The result can be used in `SelectBuilder#From` or `SelectBuilder#Join` through `SelectBuilder#Var`.
+// Without alias, it builds a bare "VALUES (...), (...)" list, which can be used in `CTEQueryBuilder#As`.
+//
+// In MySQL, every row is written as "ROW(...)" as required by the table value constructor.
+type ValuesTableBuilder struct {
+	rows  [][]string
+	alias string
+	cols  []string
+
+	args *Args
+
+	injection *injection
+	marker    injectionMarker
+}
+
+var _ Builder = new(ValuesTableBuilder)
+
+// ValuesTable creates a new VALUES table builder with rows.
+func ValuesTable(rows [][]interface{}) *ValuesTableBuilder {
+	return DefaultFlavor.NewValuesTableBuilder().Rows(rows...)
+}
+
+// Values creates a new VALUES table builder with optional column names and rows.
+// Column names are written after the alias set by `ValuesTableBuilder#As`.
+func Values(cols []string, rows ...[]interface{}) *ValuesTableBuilder {
+	return DefaultFlavor.NewValuesTableBuilder().Cols(cols...).Rows(rows...)
+}
+
+// Cols sets column names of the VALUES table.
+// Column names are written only when alias is set by `As`.
+func (vtb *ValuesTableBuilder) Cols(col ...string) *ValuesTableBuilder {
+	vtb.cols = col
+	return vtb
+}
+
+// Rows adds rows of values to the VALUES list.
+func (vtb *ValuesTableBuilder) Rows(rows ...[]interface{}) *ValuesTableBuilder {
+	for _, row := range rows {
+		vtb.Row(row...)
+	}
+
+	return vtb
+}
+
+// Row adds a row of values to the VALUES list.
+func (vtb *ValuesTableBuilder) Row(value ...interface{}) *ValuesTableBuilder {
+	placeholders := make([]string, 0, len(value))
+
+	for _, v := range value {
+		placeholders = append(placeholders, vtb.args.Add(v))
+	}
+
+	vtb.rows = append(vtb.rows, placeholders)
+	vtb.marker = valuesTableMarkerAfterValues
+	return vtb
+}
+
+// As sets the alias of the VALUES table and optional column names.
+// The VALUES list is wrapped by parentheses once alias is set.
+// If col is empty, column names set by `Cols` are kept.
+func (vtb *ValuesTableBuilder) As(alias string, col ...string) *ValuesTableBuilder {
+	vtb.alias = alias
+
+	if len(col) > 0 {
+		vtb.cols = col
+	}
+
+	vtb.marker = valuesTableMarkerAfterAs
+	return vtb
+}
+
+// NumRow returns the number of rows in the VALUES list.
+func (vtb *ValuesTableBuilder) NumRow() int {
+	return len(vtb.rows)
+}
+
+// String returns the compiled VALUES string.
+func (vtb *ValuesTableBuilder) String() string {
+	s, _ := vtb.Build()
+	return s
+}
+
+// Build returns compiled VALUES string and args.
+func (vtb *ValuesTableBuilder) Build() (sql string, args []interface{}) {
+	return vtb.BuildWithFlavor(vtb.args.Flavor)
+}
+
+// BuildWithFlavor returns compiled VALUES string and args with flavor and initial args.
+func (vtb *ValuesTableBuilder) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	buf := newStringBuilder()
+	vtb.injection.WriteTo(buf, valuesTableMarkerInit)
+
+	if vtb.alias != "" {
+		buf.WriteLeadingString("(")
+	}
+
+	if len(vtb.rows) > 0 {
+		if vtb.alias != "" {
+			buf.WriteString("VALUES ")
+		} else {
+			buf.WriteLeadingString("VALUES ")
+		}
+
+		for i, row := range vtb.rows {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+
+			if flavor == MySQL {
+				buf.WriteString("ROW")
+			}
+
+			buf.WriteRune('(')
+			buf.WriteStrings(row, ", ")
+			buf.WriteRune(')')
+		}
+	}
+
+	vtb.injection.WriteTo(buf, valuesTableMarkerAfterValues)
+
+	if vtb.alias != "" {
+		buf.WriteString(") AS ")
+		buf.WriteString(vtb.alias)
+
+		if len(vtb.cols) > 0 {
+			buf.WriteString(" (")
+			buf.WriteStrings(vtb.cols, ", ")
+			buf.WriteRune(')')
+		}
+
+		vtb.injection.WriteTo(buf, valuesTableMarkerAfterAs)
+	}
+
+	return vtb.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
+}
+
+// SetFlavor sets the flavor of compiled sql.
+func (vtb *ValuesTableBuilder) SetFlavor(flavor Flavor) (old Flavor) {
+	old = vtb.args.Flavor
+	vtb.args.Flavor = flavor
+	return
+}
+
+// Flavor returns flavor of builder
+func (vtb *ValuesTableBuilder) Flavor() Flavor {
+	return vtb.args.Flavor
+}
+
+// SQL adds an arbitrary sql to current position.
+func (vtb *ValuesTableBuilder) SQL(sql string) *ValuesTableBuilder {
+	vtb.injection.SQL(vtb.marker, sql)
+	return vtb
+}
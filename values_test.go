@@ -0,0 +1,94 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/huandu/go-assert"
+)
+
+func ExampleValuesTable() {
+	vt := ValuesTable([][]interface{}{
+		{1, "a"},
+		{2, "b"},
+	}).As("t", "id", "name")
+
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("u.id", "t.name")
+	sb.From("users u")
+	sb.Join(sb.Var(vt), "t.id = u.id")
+	sb.Where(sb.GreaterThan("u.level", 10))
+
+	sql, args := sb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT u.id, t.name FROM users u JOIN (VALUES ($1, $2), ($3, $4)) AS t (id, name) ON t.id = u.id WHERE u.level > $5
+	// [1 a 2 b 10]
+}
+
+func TestValuesTableBuilder(t *testing.T) {
+	a := assert.New(t)
+	vtb := newValuesTableBuilder()
+	vtb.SQL("/* init */")
+	vtb.Row(1, "a").Row(2, "b")
+	vtb.SQL("/* after values */")
+	a.Equal(vtb.NumRow(), 2)
+
+	sql, args := vtb.BuildWithFlavor(MySQL)
+	a.Equal(sql, "/* init */ VALUES ROW(?, ?), ROW(?, ?) /* after values */")
+	a.Equal(args, []interface{}{1, "a", 2, "b"})
+
+	vtb.As("t")
+	vtb.SQL("/* after as */")
+	a.Equal(vtb.String(), "/* init */ (VALUES (?, ?), (?, ?) /* after values */) AS t /* after as */")
+
+	cte := With(CTEQuery("t", "id").As(ValuesTable([][]interface{}{{1}, {2}})))
+	sb := cte.Select("id").From("t")
+	a.Equal(sb.String(), "WITH t (id) AS (VALUES ROW(?), ROW(?)) SELECT id FROM t")
+}
+
+func TestValuesTableBuilderGetFlavor(t *testing.T) {
+	a := assert.New(t)
+	vtb := newValuesTableBuilder()
+
+	vtb.SetFlavor(PostgreSQL)
+	a.Equal(PostgreSQL, vtb.Flavor())
+
+	vtbClick := ClickHouse.NewValuesTableBuilder()
+	a.Equal(ClickHouse, vtbClick.Flavor())
+}
+
+func ExampleValues() {
+	vt := Values([]string{"id", "name"}, []interface{}{1, "a"}, []interface{}{2, "b"}).As("t")
+
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("t.id", "t.name")
+	sb.From(sb.Var(vt))
+
+	sql, args := sb.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// SELECT t.id, t.name FROM (VALUES ($1, $2), ($3, $4)) AS t (id, name)
+	// [1 a 2 b]
+}
+
+func TestValues(t *testing.T) {
+	a := assert.New(t)
+	vt := Values(nil, []interface{}{1})
+	a.Equal(vt.String(), "VALUES ROW(?)")
+
+	vt = Values([]string{"id"}, []interface{}{1}).As("t", "x")
+	a.Equal(vt.String(), "(VALUES ROW(?)) AS t (x)")
+
+	vt = Values([]string{"id"}, []interface{}{1})
+	vt.SetFlavor(SQLite)
+	cte := With(CTEQuery("t").As(vt))
+	a.Equal(cte.Select("id").From("t").String(), "WITH t AS (VALUES ROW(?)) SELECT id FROM t")
+}
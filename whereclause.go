@@ -21,6 +21,25 @@ func NewWhereClause() *WhereClause {
 	return &WhereClause{}
 }
 
+// numExprs returns the number of non-empty expressions in all clauses.
+func (wc *WhereClause) numExprs() int {
+	if wc == nil {
+		return 0
+	}
+
+	n := 0
+
+	for _, c := range wc.clauses {
+		for _, expr := range c.andExprs {
+			if expr != "" {
+				n++
+			}
+		}
+	}
+
+	return n
+}
+
 // CopyWhereClause creates a copy of the whereClause.
 func CopyWhereClause(whereClause *WhereClause) *WhereClause {
 	clauses := make([]clause, len(whereClause.clauses))
@@ -35,11 +54,14 @@ func CopyWhereClause(whereClause *WhereClause) *WhereClause {
 type clause struct {
 	args     *Args
 	andExprs []string
+
+	// If or is true, the clause is joined with previous clauses by OR.
+	or bool
 }
 
-func (c *clause) Build(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+func (c *clause) Build(flavor Flavor, andSep string, initialArg ...interface{}) (sql string, args []interface{}) {
 	buf := newStringBuilder()
-	buf.WriteStrings(c.andExprs, " AND ")
+	buf.WriteStrings(c.andExprs, andSep)
 	sql, args = c.args.CompileWithFlavor(buf.String(), flavor, initialArg...)
 	return
 }
@@ -48,28 +70,72 @@ func (c *clause) Build(flavor Flavor, initialArg ...interface{}) (sql string, ar
 // It's useful when the WhereClause in a build can be changed.
 type whereClauseProxy struct {
 	*WhereClause
+
+	// andSep is the separator between conditions.
+	// It's set by builders when building pretty SQL.
+	andSep string
 }
 
 var _ Builder = new(whereClauseProxy)
 
+// BuildWithFlavor builds the proxied WHERE clause with andSep.
+func (wcp *whereClauseProxy) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
+	return wcp.WhereClause.buildWithFlavor(flavor, wcp.andSep, initialArg...)
+}
+
 // BuildWithFlavor builds a WHERE clause with the specified flavor and initial arguments.
 func (wc *WhereClause) BuildWithFlavor(flavor Flavor, initialArg ...interface{}) (sql string, args []interface{}) {
-	if len(wc.clauses) == 0 {
+	return wc.buildWithFlavor(flavor, "", initialArg...)
+}
+
+func (wc *WhereClause) buildWithFlavor(flavor Flavor, andSep string, initialArg ...interface{}) (sql string, args []interface{}) {
+	return buildClauses("WHERE ", wc.clauses, flavor, andSep, initialArg...)
+}
+
+// buildClauses builds all clauses after keyword.
+// If andSep is empty, " AND " is used.
+func buildClauses(keyword string, clauses []clause, flavor Flavor, andSep string, initialArg ...interface{}) (sql string, args []interface{}) {
+	if len(clauses) == 0 {
 		return "", nil
 	}
 
-	buf := newStringBuilder()
-	buf.WriteLeadingString("WHERE ")
+	if andSep == "" {
+		andSep = " AND "
+	}
+
+	sql, args = clauses[0].Build(flavor, andSep, initialArg...)
+	body := sql
 
-	sql, args = wc.clauses[0].Build(flavor, initialArg...)
-	buf.WriteString(sql)
+	// Track the top-level operator of body to decide whether it must be wrapped by parentheses.
+	// Conditions before an OR group are grouped together, and so are all conditions before
+	// an AND expression following an OR group, so that AND always applies to all previous conditions.
+	isAnd := len(clauses[0].andExprs) > 1
+	isOr := false
 
-	for _, clause := range wc.clauses[1:] {
-		buf.WriteString(" AND ")
-		sql, args = clause.Build(flavor, args...)
-		buf.WriteString(sql)
+	for _, clause := range clauses[1:] {
+		sql, args = clause.Build(flavor, andSep, args...)
+
+		if clause.or {
+			if isAnd {
+				body = "(" + body + ")"
+			}
+
+			body += " OR (" + sql + ")"
+			isAnd, isOr = false, true
+			continue
+		}
+
+		if isOr {
+			body = "(" + body + ")"
+		}
+
+		body += andSep + sql
+		isAnd, isOr = true, false
 	}
 
+	buf := newStringBuilder()
+	buf.WriteLeadingString(keyword)
+	buf.WriteString(body)
 	return buf.String(), args
 }
 
@@ -107,7 +173,7 @@ func (wc *WhereClause) AddWhereExpr(args *Args, andExpr ...string) *WhereClause
 	if len(wc.clauses) > 0 {
 		lastClause := &wc.clauses[len(wc.clauses)-1]
 
-		if lastClause.args == args {
+		if lastClause.args == args && !lastClause.or {
 			lastClause.andExprs = append(lastClause.andExprs, andExpr...)
 			return wc
 		}
@@ -120,6 +186,26 @@ func (wc *WhereClause) AddWhereExpr(args *Args, andExpr ...string) *WhereClause
 	return wc
 }
 
+// AddWhereExprOr adds a group of AND expressions to WHERE clause with the specified arguments.
+// The group is wrapped by parentheses and joined with all existing conditions by OR.
+//
+// For instance, if wc has conditions "a = 1 AND b = 2", calling AddWhereExprOr with "c = 3" and "d = 4"
+// builds "WHERE (a = 1 AND b = 2) OR (c = 3 AND d = 4)".
+// Conditions added after the OR group apply to all previous conditions,
+// e.g. adding "e = 5" builds "WHERE ((a = 1 AND b = 2) OR (c = 3 AND d = 4)) AND e = 5".
+func (wc *WhereClause) AddWhereExprOr(args *Args, andExpr ...string) *WhereClause {
+	if len(andExpr) == 0 || estimateStringsBytes(andExpr) == 0 {
+		return wc
+	}
+
+	wc.clauses = append(wc.clauses, clause{
+		args:     args,
+		andExprs: andExpr,
+		or:       true,
+	})
+	return wc
+}
+
 // AddWhereClause adds all clauses in the whereClause to the wc.
 func (wc *WhereClause) AddWhereClause(whereClause *WhereClause) *WhereClause {
 	if whereClause == nil {
@@ -161,6 +161,30 @@ func ExampleWhereClause_AddWhereExpr() {
 	// [Charmy Huan 10]
 }
 
+func ExampleWhereClause_AddWhereExprOr() {
+	whereClause := NewWhereClause()
+	cond := NewCond()
+
+	whereClause.AddWhereExpr(
+		cond.Args,
+		cond.Equal("a", 1),
+		cond.Equal("b", 2),
+	)
+	whereClause.AddWhereExprOr(
+		cond.Args,
+		cond.Equal("c", 3),
+		cond.Equal("d", 4),
+	)
+
+	sql, args := whereClause.Build()
+	fmt.Println(sql)
+	fmt.Println(args)
+
+	// Output:
+	// WHERE (a = ? AND b = ?) OR (c = ? AND d = ?)
+	// [1 2 3 4]
+}
+
 func ExampleWhereClause_AddWhereClause() {
 	sb := Select("level").From("users")
 	sb.Where(
@@ -330,3 +354,49 @@ func TestWhereClauseCopyGetFlavor(t *testing.T) {
 	flavor := wcCopy.Flavor()
 	a.Equal(PostgreSQL, flavor)
 }
+
+func TestWhereClauseAddWhereExprOr(t *testing.T) {
+	a := assert.New(t)
+	wc := NewWhereClause()
+	cond := NewCond()
+
+	// Empty expressions are ignored.
+	wc.AddWhereExprOr(cond.Args)
+	wc.AddWhereExprOr(cond.Args, "", "")
+	sql, args := wc.Build()
+	a.Equal(sql, "")
+	a.Assert(args == nil)
+
+	// The first OR group is written as is.
+	wc.AddWhereExprOr(cond.Args, cond.Equal("a", 1))
+	sql, _ = wc.Build()
+	a.Equal(sql, "WHERE a = ?")
+
+	// AND expressions added after an OR group must not be merged into the group.
+	wc.AddWhereExprOr(cond.Args, cond.Equal("b", 2), cond.Equal("c", 3))
+	wc.AddWhereExpr(cond.Args, cond.Equal("d", 4))
+
+	sb := PostgreSQL.NewSelectBuilder()
+	sb.Select("*").From("t").AddWhereClause(wc)
+	sql, args = sb.Build()
+	a.Equal(sql, "SELECT * FROM t WHERE (a = $1 OR (b = $2 AND c = $3)) AND d = $4")
+	a.Equal(args, []interface{}{1, 2, 3, 4})
+}
+
+func TestWhereClauseWhereAfterOr(t *testing.T) {
+	a := assert.New(t)
+	sb := NewSelectBuilder()
+	sb.Select("*").From("t")
+	sb.Where(sb.Equal("a", 1), sb.Equal("b", 2))
+	sb.AddWhereExprOr(sb.Args, sb.Equal("c", 3), sb.Equal("d", 4))
+	sb.Where(sb.Equal("tenant_id", 5))
+
+	sql, args := sb.Build()
+	a.Equal(sql, "SELECT * FROM t WHERE ((a = ? AND b = ?) OR (c = ? AND d = ?)) AND tenant_id = ?")
+	a.Equal(args, []interface{}{1, 2, 3, 4, 5})
+
+	sb.AddWhereExprOr(sb.Args, sb.Equal("e", 6))
+	sb.AddWhereExprOr(sb.Args, sb.Equal("f", 7))
+	sql, _ = sb.Build()
+	a.Equal(sql, "SELECT * FROM t WHERE (((a = ? AND b = ?) OR (c = ? AND d = ?)) AND tenant_id = ?) OR (e = ?) OR (f = ?)")
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 Huan Du. All rights reserved.
+// Licensed under the MIT license that can be found in the LICENSE file.
+
+package sqlbuilder
+
+// WindowSpec is the specification of a window used by window functions,
+// e.g. "PARTITION BY user_id ORDER BY created_at DESC ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW".
+type WindowSpec struct {
+	// PartitionBy is the list of expressions in PARTITION BY.
+	PartitionBy []string
+
+	// OrderBy is the list of expressions in ORDER BY.
+	// Every expression can have a direction, e.g. "created_at DESC".
+	OrderBy []string
+
+	// Frame is the frame clause, e.g. "ROWS BETWEEN 1 PRECEDING AND CURRENT ROW".
+	Frame string
+}
+
+// String returns the window specification without parentheses.
+func (ws WindowSpec) String() string {
+	buf := newStringBuilder()
+
+	if len(ws.PartitionBy) > 0 {
+		buf.WriteLeadingString("PARTITION BY ")
+		buf.WriteStrings(ws.PartitionBy, ", ")
+	}
+
+	if len(ws.OrderBy) > 0 {
+		buf.WriteLeadingString("ORDER BY ")
+		buf.WriteStrings(ws.OrderBy, ", ")
+	}
+
+	if ws.Frame != "" {
+		buf.WriteLeadingString(ws.Frame)
+	}
+
+	return buf.String()
+}
+
+// supportsWindowClause returns true if the flavor supports named window in WINDOW clause.
+func (f Flavor) supportsWindowClause() bool {
+	switch f {
+	case MySQL, MariaDB, PostgreSQL, SQLite, ClickHouse, Oracle, ANSI, DuckDB:
+		return true
+	}
+
+	return false
+}